@@ -16,6 +16,7 @@ package kubernetes
 
 import (
 	"context"
+	"io"
 	"io/ioutil"
 
 	"github.com/kiegroup/kogito-operator/core/client"
@@ -28,6 +29,8 @@ type PodInterface interface {
 	GetLogs(namespace, podName, containerName string) (string, error)
 	// Wait until pod is terminated and then return pod log
 	GetLogsWithFollow(namespace, podName, containerName string) (string, error)
+	// Return stream following pod log until pod is terminated or context is cancelled
+	StreamLogsWithFollow(ctx context.Context, namespace, podName, containerName string) (io.ReadCloser, error)
 }
 
 type pod struct {
@@ -48,6 +51,15 @@ func (pod *pod) GetLogsWithFollow(namespace, podName, containerName string) (str
 	return pod.getLogs(namespace, podName, containerName, true)
 }
 
+func (pod *pod) StreamLogsWithFollow(ctx context.Context, namespace, podName, containerName string) (io.ReadCloser, error) {
+	log.Debug("About to stream log of pod from cluster", "pod name", podName, "namespace", namespace)
+	podLogOpts := corev1.PodLogOptions{
+		Follow:    true,
+		Container: containerName,
+	}
+	return pod.client.KubernetesExtensionCli.CoreV1().Pods(namespace).GetLogs(podName, &podLogOpts).Stream(ctx)
+}
+
 func (pod *pod) getLogs(namespace, podName, containerName string, follow bool) (string, error) {
 	log.Debug("About to fetch log of pod from cluster", "pod name", podName, "namespace", namespace, "follow", follow)
 	podLogOpts := corev1.PodLogOptions{
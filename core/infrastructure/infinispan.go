@@ -23,6 +23,9 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"strings"
+	"time"
 )
 
 const (
@@ -42,6 +45,10 @@ const (
 
 	// InfinispanInstanceName is the default name for Infinispan managed by KogitoInfra
 	InfinispanInstanceName = "kogito-infinispan"
+
+	// infinispanConditionTypeWellFormed condition set by Infinispan Operator once the cluster is ready
+	infinispanConditionTypeWellFormed = "wellFormed"
+	infinispanReadyPollInterval       = 5 * time.Second
 )
 
 // InfinispanHandler ...
@@ -50,6 +57,9 @@ type InfinispanHandler interface {
 	IsInfinispanAvailable() bool
 	FetchInfinispanInstanceURI(key types.NamespacedName) (string, error)
 	GetInfinispanCredential(infinispanInstance *ispn.Infinispan) (*InfinispanCredential, error)
+	CreateInfinispanInstanceIfNotExists(namespace, name string, replicas int32) error
+	WaitForInfinispanInstanceReady(namespace, name string, timeoutInMin int) error
+	DeleteInfinispanInstance(namespace, name string) error
 }
 
 type infinispanHandler struct {
@@ -131,6 +141,63 @@ func (i *infinispanHandler) GetInfinispanCredential(infinispanInstance *ispn.Inf
 	return nil, nil
 }
 
+// CreateInfinispanInstanceIfNotExists creates an Infinispan cluster with given replicas if it doesn't exist
+func (i *infinispanHandler) CreateInfinispanInstanceIfNotExists(namespace, name string, replicas int32) error {
+	i.Log.Debug("Going to create infinispan instance", "infinispan instance", name, "replicas", replicas)
+	infinispanInstance := &ispn.Infinispan{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: ispn.InfinispanSpec{
+			Replicas: replicas,
+		},
+	}
+	if err := kubernetes.ResourceC(i.Client).CreateIfNotExists(infinispanInstance); err != nil {
+		i.Log.Error(err, "Error occurs while creating infinispan instance", "infinispan instance", name)
+		return err
+	}
+	return nil
+}
+
+// WaitForInfinispanInstanceReady waits until the given Infinispan cluster is reported as well formed
+func (i *infinispanHandler) WaitForInfinispanInstanceReady(namespace, name string, timeoutInMin int) error {
+	key := types.NamespacedName{Name: name, Namespace: namespace}
+	err := wait.PollImmediate(infinispanReadyPollInterval, time.Duration(timeoutInMin)*time.Minute, func() (bool, error) {
+		infinispanInstance, err := i.FetchInfinispanInstance(key)
+		if err != nil || infinispanInstance == nil {
+			return false, err
+		}
+		for _, condition := range infinispanInstance.Status.Conditions {
+			if strings.EqualFold(condition.Type, infinispanConditionTypeWellFormed) {
+				return condition.Status == string(metav1.ConditionTrue), nil
+			}
+		}
+		return false, nil
+	})
+	if err != nil {
+		return fmt.Errorf("infinispan instance %s not ready in namespace %s: %v", name, namespace, err)
+	}
+	return nil
+}
+
+// DeleteInfinispanInstance deletes the given Infinispan cluster if it exists
+func (i *infinispanHandler) DeleteInfinispanInstance(namespace, name string) error {
+	infinispanInstance, err := i.FetchInfinispanInstance(types.NamespacedName{Name: name, Namespace: namespace})
+	if err != nil {
+		return err
+	} else if infinispanInstance == nil {
+		return nil
+	}
+
+	i.Log.Debug("Going to delete infinispan instance", "infinispan instance", name)
+	if err := kubernetes.ResourceC(i.Client).Delete(infinispanInstance); err != nil {
+		i.Log.Error(err, "Error occurs while deleting infinispan instance", "infinispan instance", name)
+		return err
+	}
+	return nil
+}
+
 // getDefaultInfinispanCredential will return the credential to be used by internal services
 func getDefaultInfinispanCredential(infinispanSecret *corev1.Secret) (*InfinispanCredential, error) {
 	return findInfinispanCredentialByUsernameOrFirst(defaultInfinispanUser, infinispanSecret)
@@ -0,0 +1,71 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"testing"
+
+	ispn "github.com/infinispan/infinispan-operator/pkg/apis/infinispan/v1"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"github.com/kiegroup/kogito-operator/core/test"
+	"github.com/kiegroup/kogito-operator/meta"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func Test_infinispanInstanceLifecycle(t *testing.T) {
+	ns := t.Name()
+
+	readyInfinispan := &ispn.Infinispan{
+		ObjectMeta: v1.ObjectMeta{Name: "ready-infinispan", Namespace: ns},
+		Status: ispn.InfinispanStatus{
+			Conditions: []ispn.InfinispanCondition{
+				{
+					Type:   "wellFormed",
+					Status: string(v1.ConditionTrue),
+				},
+			},
+		},
+	}
+
+	cli := test.NewFakeClientBuilder().AddK8sObjects(readyInfinispan).Build()
+	context := &operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	infinispanHandler := NewInfinispanHandler(context)
+	key := types.NamespacedName{Name: "infinispan", Namespace: ns}
+
+	err := infinispanHandler.CreateInfinispanInstanceIfNotExists(ns, "infinispan", 2)
+	assert.NoError(t, err)
+	infinispanInstance, err := infinispanHandler.FetchInfinispanInstance(key)
+	assert.NoError(t, err)
+	assert.NotNil(t, infinispanInstance)
+	assert.Equal(t, int32(2), infinispanInstance.Spec.Replicas)
+
+	err = infinispanHandler.WaitForInfinispanInstanceReady(ns, "ready-infinispan", 1)
+	assert.NoError(t, err)
+
+	err = infinispanHandler.DeleteInfinispanInstance(ns, "infinispan")
+	assert.NoError(t, err)
+	infinispanInstance, err = infinispanHandler.FetchInfinispanInstance(key)
+	assert.NoError(t, err)
+	assert.Nil(t, infinispanInstance)
+
+	err = infinispanHandler.DeleteInfinispanInstance(ns, "not-existing")
+	assert.NoError(t, err)
+}
@@ -15,12 +15,21 @@
 package infrastructure
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
 	"github.com/kiegroup/kogito-operator/core/infrastructure/kafka/v1beta2"
 	"github.com/kiegroup/kogito-operator/core/operator"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	controllercli "sigs.k8s.io/controller-runtime/pkg/client"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 )
 
 const (
@@ -35,6 +44,24 @@ const (
 
 	// KafkaInstanceName is the default name for the Kafka cluster managed by KogitoInfra
 	KafkaInstanceName = "kogito-kafka"
+
+	kafkaPlainListenerType = "plain"
+	// kafkaExternalListenerType listener type exposed outside of the cluster (NodePort, LoadBalancer...)
+	kafkaExternalListenerType = "external"
+
+	kafkaReadyPollInterval = 5 * time.Second
+	kafkaReadyTimeout      = 10 * time.Minute
+
+	kafkaTopicDeletedPollInterval = 5 * time.Second
+
+	kafkaTopicRetentionMsConfigKey = "retention.ms"
+	// KafkaTopicRetentionMsUnset is returned as retention of kafka topics not configuring it
+	KafkaTopicRetentionMsUnset = -1
+
+	// kafkaBootstrapServiceSuffix suffix of the service created by Strimzi to bootstrap connections to the kafka brokers
+	kafkaBootstrapServiceSuffix = "-kafka-bootstrap"
+	// kafkaPlainListenerPort port of the plain listener of kafka instances deployed for Kogito
+	kafkaPlainListenerPort = 9092
 )
 
 var (
@@ -46,9 +73,35 @@ var (
 type KafkaHandler interface {
 	IsStrimziAvailable() bool
 	FetchKafkaInstance(key types.NamespacedName) (*v1beta2.Kafka, error)
+	FetchKafkaInstanceStatus(key types.NamespacedName) (*v1beta2.KafkaStatus, error)
+	GetKafkaClusterStatus(key types.NamespacedName) (*KafkaClusterSummary, error)
+	GetKafkaReplicaCount(key types.NamespacedName) (int32, error)
+	GetKafkaZookeeperReplicas(key types.NamespacedName) (int32, error)
+	GetKafkaListenerCount(key types.NamespacedName) (int, error)
+	GetKafkaSpecReplicas(key types.NamespacedName) (int32, error)
+	SetKafkaSpecReplicas(key types.NamespacedName, replicas int32) error
+	GetKafkaClusterVersion(key types.NamespacedName) (string, error)
+	SetKafkaClusterVersion(key types.NamespacedName, version string) error
+	WaitForKafkaReady(namespace, instanceName string, timeoutInMin int) error
+	WaitForKafkaListenerStatusPopulated(key types.NamespacedName, timeoutInMin int) error
+	ListKafkaInstancesByLabel(namespace string, labels map[string]string) ([]v1beta2.Kafka, error)
 	FetchKafkaTopic(key types.NamespacedName) (*v1beta2.KafkaTopic, error)
+	GetKafkaTopicPartitionCount(namespace, topicName string) (int, error)
+	GetKafkaTopicRetentionMs(namespace, topicName string) (int64, error)
+	CheckKafkaTopicConfigMatch(namespace, topicName string, expectedConfig map[string]string) error
+	WaitForKafkaTopicDeleted(namespace, topicName string, timeoutInMin int) error
+	FetchKafkaUser(key types.NamespacedName) (*v1beta2.KafkaUser, error)
+	FetchKafkaUsersByLabel(namespace string, labels map[string]string) ([]v1beta2.KafkaUser, error)
 	CreateKafkaTopic(topicName, kafkaName, kafkaNamespace string) (*v1beta2.KafkaTopic, error)
+	DeleteKafkaInstance(key types.NamespacedName, deleteTopics bool) error
+	FetchKafkaConnect(key types.NamespacedName) (*v1beta2.KafkaConnect, error)
+	CreateKafkaConnectIfNotExists(namespace, name, kafkaInstanceName string, replicas int32) error
+	WaitForKafkaConnectReady(namespace, name string, timeoutInMin int) error
+	DeleteKafkaConnect(namespace, name string) error
 	ResolveKafkaServerURI(kafka *v1beta2.Kafka) (string, error)
+	GetKafkaBootstrapServers(kafka *v1beta2.Kafka, listenerType string) (string, error)
+	GetKafkaExternalListenerBootstrap(key types.NamespacedName) (string, error)
+	IsKafkaTLSEnabled(kafka *v1beta2.Kafka) bool
 }
 
 type kafkaHandler struct {
@@ -82,6 +135,227 @@ func (k *kafkaHandler) FetchKafkaInstance(key types.NamespacedName) (*v1beta2.Ka
 	}
 }
 
+// FetchKafkaInstanceStatus returns only the status of the given kafka instance, nil if the instance does not exist
+func (k *kafkaHandler) FetchKafkaInstanceStatus(key types.NamespacedName) (*v1beta2.KafkaStatus, error) {
+	kafkaInstance, err := k.FetchKafkaInstance(key)
+	if err != nil || kafkaInstance == nil {
+		return nil, err
+	}
+	return &kafkaInstance.Status, nil
+}
+
+// KafkaClusterSummary summarizes the status of a kafka instance
+type KafkaClusterSummary struct {
+	IsReady            bool
+	ObservedGeneration int64
+	ListenerCount      int
+	// BootstrapServers addresses of the plain listener, empty if not reported yet
+	BootstrapServers string
+}
+
+// GetKafkaClusterStatus returns the summary of the status of the given kafka instance
+func (k *kafkaHandler) GetKafkaClusterStatus(key types.NamespacedName) (*KafkaClusterSummary, error) {
+	kafkaInstance, err := k.FetchKafkaInstance(key)
+	if err != nil {
+		return nil, err
+	} else if kafkaInstance == nil {
+		return nil, fmt.Errorf("kafka instance %s not found in namespace %s", key.Name, key.Namespace)
+	}
+
+	summary := &KafkaClusterSummary{
+		IsReady:            isKafkaReady(kafkaInstance),
+		ObservedGeneration: kafkaInstance.Status.ObservedGeneration,
+		ListenerCount:      len(kafkaInstance.Status.Listeners),
+	}
+	if bootstrapServers, err := k.GetKafkaBootstrapServers(kafkaInstance, kafkaPlainListenerType); err == nil {
+		summary.BootstrapServers = bootstrapServers
+	}
+	return summary, nil
+}
+
+// GetKafkaReplicaCount returns the number of configured replicas of the given kafka instance
+func (k *kafkaHandler) GetKafkaReplicaCount(key types.NamespacedName) (int32, error) {
+	kafkaInstance, err := k.FetchKafkaInstance(key)
+	if err != nil {
+		return 0, err
+	} else if kafkaInstance == nil {
+		return 0, fmt.Errorf("kafka instance %s not found in namespace %s", key.Name, key.Namespace)
+	}
+	return kafkaInstance.Spec.Kafka.Replicas, nil
+}
+
+// GetKafkaZookeeperReplicas returns the number of configured ZooKeeper replicas of the given kafka instance
+func (k *kafkaHandler) GetKafkaZookeeperReplicas(key types.NamespacedName) (int32, error) {
+	kafkaInstance, err := k.FetchKafkaInstance(key)
+	if err != nil {
+		return 0, err
+	} else if kafkaInstance == nil {
+		return 0, fmt.Errorf("kafka instance %s not found in namespace %s", key.Name, key.Namespace)
+	}
+	return kafkaInstance.Spec.Zookeeper.Replicas, nil
+}
+
+// GetKafkaListenerCount returns the number of listeners configured in the spec of the given kafka instance
+func (k *kafkaHandler) GetKafkaListenerCount(key types.NamespacedName) (int, error) {
+	kafkaInstance, err := k.FetchKafkaInstance(key)
+	if err != nil {
+		return 0, err
+	} else if kafkaInstance == nil {
+		return 0, fmt.Errorf("kafka instance %s not found in namespace %s", key.Name, key.Namespace)
+	}
+	return len(kafkaInstance.Spec.Kafka.Listeners), nil
+}
+
+// GetKafkaSpecReplicas returns the number of replicas set in the spec of the given kafka instance, as changed by SetKafkaSpecReplicas
+func (k *kafkaHandler) GetKafkaSpecReplicas(key types.NamespacedName) (int32, error) {
+	return k.GetKafkaReplicaCount(key)
+}
+
+// SetKafkaSpecReplicas patches the number of replicas of the given kafka instance and waits for the kafka instance to be ready
+func (k *kafkaHandler) SetKafkaSpecReplicas(key types.NamespacedName, replicas int32) error {
+	k.Log.Debug("Going to scale kafka instance", "kafka instance", key.Name, "replicas", replicas)
+	return k.patchKafkaClusterSpec(key, map[string]interface{}{"replicas": replicas})
+}
+
+// GetKafkaClusterVersion returns the kafka version set in the spec of the given kafka instance
+func (k *kafkaHandler) GetKafkaClusterVersion(key types.NamespacedName) (string, error) {
+	kafkaInstance, err := k.FetchKafkaInstance(key)
+	if err != nil {
+		return "", err
+	} else if kafkaInstance == nil {
+		return "", fmt.Errorf("kafka instance %s not found in namespace %s", key.Name, key.Namespace)
+	}
+	return kafkaInstance.Spec.Kafka.Version, nil
+}
+
+// SetKafkaClusterVersion patches the kafka version of the given kafka instance and waits for the kafka instance to be ready
+func (k *kafkaHandler) SetKafkaClusterVersion(key types.NamespacedName, version string) error {
+	k.Log.Debug("Going to change kafka instance version", "kafka instance", key.Name, "version", version)
+	return k.patchKafkaClusterSpec(key, map[string]interface{}{"version": version})
+}
+
+// patchKafkaClusterSpec patches the kafka cluster spec of the given kafka instance and waits for Strimzi to reconcile it
+func (k *kafkaHandler) patchKafkaClusterSpec(key types.NamespacedName, kafkaClusterSpecPatch map[string]interface{}) error {
+	kafkaInstance, err := k.FetchKafkaInstance(key)
+	if err != nil {
+		return err
+	} else if kafkaInstance == nil {
+		return fmt.Errorf("kafka instance %s not found in namespace %s", key.Name, key.Namespace)
+	}
+
+	// Custom resources don't support strategic merge patch, JSON merge patch is used instead
+	patch, err := json.Marshal(map[string]interface{}{"spec": map[string]interface{}{"kafka": kafkaClusterSpecPatch}})
+	if err != nil {
+		return err
+	}
+	if err := k.Client.ControlCli.Patch(context.TODO(), kafkaInstance, controllercli.RawPatch(types.MergePatchType, patch)); err != nil {
+		k.Log.Error(err, "Error occurs while patching kafka instance", "kafka instance", key.Name)
+		return err
+	}
+
+	generation := kafkaInstance.Generation
+	return wait.PollImmediate(kafkaReadyPollInterval, kafkaReadyTimeout, func() (bool, error) {
+		kafkaInstance, err := k.FetchKafkaInstance(key)
+		if err != nil || kafkaInstance == nil {
+			return false, err
+		}
+		// Wait for Strimzi to reconcile the patched spec before checking readiness
+		if kafkaInstance.Status.ObservedGeneration < generation {
+			return false, nil
+		}
+		return isKafkaReady(kafkaInstance), nil
+	})
+}
+
+// WaitForKafkaReady waits until the given kafka instance is ready and its bootstrap service has endpoints
+func (k *kafkaHandler) WaitForKafkaReady(namespace, instanceName string, timeoutInMin int) error {
+	key := types.NamespacedName{Name: instanceName, Namespace: namespace}
+	deadline := time.Now().Add(time.Duration(timeoutInMin) * time.Minute)
+	err := wait.PollImmediate(kafkaReadyPollInterval, time.Until(deadline), func() (bool, error) {
+		return k.isKafkaReachable(key)
+	})
+	if err != nil {
+		return fmt.Errorf("kafka instance %s not ready in namespace %s: %v", instanceName, namespace, err)
+	}
+	// Server URI is resolved from the listener status, which can be populated after the ready condition
+	return k.waitForKafkaListenerStatusPopulated(key, time.Until(deadline))
+}
+
+// WaitForKafkaListenerStatusPopulated waits until the status of the given kafka instance contains at least one listener address
+func (k *kafkaHandler) WaitForKafkaListenerStatusPopulated(key types.NamespacedName, timeoutInMin int) error {
+	return k.waitForKafkaListenerStatusPopulated(key, time.Duration(timeoutInMin)*time.Minute)
+}
+
+func (k *kafkaHandler) waitForKafkaListenerStatusPopulated(key types.NamespacedName, timeout time.Duration) error {
+	err := wait.PollImmediate(kafkaReadyPollInterval, timeout, func() (bool, error) {
+		kafkaInstance, err := k.FetchKafkaInstance(key)
+		if err != nil || kafkaInstance == nil {
+			return false, err
+		}
+		for _, listener := range kafkaInstance.Status.Listeners {
+			if len(listener.Addresses) > 0 {
+				return true, nil
+			}
+		}
+		k.Log.Debug("kafka instance listener status not populated yet", "kafka instance", key.Name)
+		return false, nil
+	})
+	if err != nil {
+		return fmt.Errorf("kafka instance %s in namespace %s doesn't report any listener address: %v", key.Name, key.Namespace, err)
+	}
+	return nil
+}
+
+// isKafkaReachable checks whether the kafka instance is reported as ready and its bootstrap service has ready endpoints
+func (k *kafkaHandler) isKafkaReachable(key types.NamespacedName) (bool, error) {
+	kafkaInstance, err := k.FetchKafkaInstance(key)
+	if err != nil || kafkaInstance == nil {
+		return false, err
+	}
+	if !isKafkaReady(kafkaInstance) {
+		k.Log.Debug("kafka instance not ready yet", "kafka instance", key.Name)
+		return false, nil
+	}
+
+	endpoints := &corev1.Endpoints{}
+	endpointsKey := types.NamespacedName{Name: key.Name + kafkaBootstrapServiceSuffix, Namespace: key.Namespace}
+	if exists, err := kubernetes.ResourceC(k.Client).FetchWithKey(endpointsKey, endpoints); err != nil {
+		k.Log.Error(err, "Error occurs while fetching kafka bootstrap endpoints", "endpoints", endpointsKey.Name)
+		return false, err
+	} else if !exists {
+		k.Log.Debug("kafka bootstrap endpoints not exists", "endpoints", endpointsKey.Name)
+		return false, nil
+	}
+	for _, subset := range endpoints.Subsets {
+		if len(subset.Addresses) > 0 {
+			return true, nil
+		}
+	}
+	k.Log.Debug("kafka bootstrap service has no ready endpoints", "endpoints", endpointsKey.Name)
+	return false, nil
+}
+
+// isKafkaReady checks whether the kafka instance has the ready condition set
+func isKafkaReady(kafka *v1beta2.Kafka) bool {
+	for _, condition := range kafka.Status.Conditions {
+		if condition.Type == v1beta2.KafkaConditionTypeReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+func (k *kafkaHandler) ListKafkaInstancesByLabel(namespace string, labels map[string]string) ([]v1beta2.Kafka, error) {
+	k.Log.Debug("Going to list deployed kafka instances", "labels", labels)
+	kafkaInstances := &v1beta2.KafkaList{}
+	if err := kubernetes.ResourceC(k.Client).ListWithNamespaceAndLabel(namespace, kafkaInstances, labels); err != nil {
+		k.Log.Error(err, "Error occurs while listing kafka instances", "labels", labels)
+		return nil, err
+	}
+	k.Log.Debug("kafka instances found", "count", len(kafkaInstances.Items))
+	return kafkaInstances.Items, nil
+}
+
 func (k *kafkaHandler) FetchKafkaTopic(key types.NamespacedName) (*v1beta2.KafkaTopic, error) {
 	k.Log.Debug("Going to load deployed kafka topic", "topicName", key.Name)
 	kafkaTopic := &v1beta2.KafkaTopic{}
@@ -96,6 +370,105 @@ func (k *kafkaHandler) FetchKafkaTopic(key types.NamespacedName) (*v1beta2.Kafka
 	return nil, nil
 }
 
+// GetKafkaTopicPartitionCount returns the number of partitions configured in the spec of the given kafka topic
+func (k *kafkaHandler) GetKafkaTopicPartitionCount(namespace, topicName string) (int, error) {
+	kafkaTopic, err := k.FetchKafkaTopic(types.NamespacedName{Name: topicName, Namespace: namespace})
+	if err != nil {
+		return 0, err
+	} else if kafkaTopic == nil {
+		return 0, fmt.Errorf("kafka topic %s not found in namespace %s", topicName, namespace)
+	}
+	return int(kafkaTopic.Spec.Partitions), nil
+}
+
+// GetKafkaTopicRetentionMs returns the retention.ms configured for the given kafka topic, KafkaTopicRetentionMsUnset if not configured
+func (k *kafkaHandler) GetKafkaTopicRetentionMs(namespace, topicName string) (int64, error) {
+	kafkaTopic, err := k.FetchKafkaTopic(types.NamespacedName{Name: topicName, Namespace: namespace})
+	if err != nil {
+		return 0, err
+	} else if kafkaTopic == nil {
+		return 0, fmt.Errorf("kafka topic %s not found in namespace %s", topicName, namespace)
+	}
+
+	retentionMs, found := kafkaTopic.Spec.Config[kafkaTopicRetentionMsConfigKey]
+	if !found {
+		return KafkaTopicRetentionMsUnset, nil
+	}
+	retention, err := strconv.ParseInt(retentionMs, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s value %s of kafka topic %s: %v", kafkaTopicRetentionMsConfigKey, retentionMs, topicName, err)
+	}
+	return retention, nil
+}
+
+// CheckKafkaTopicConfigMatch returns an error listing all entries of the expected configuration not matching the config of the given kafka topic
+func (k *kafkaHandler) CheckKafkaTopicConfigMatch(namespace, topicName string, expectedConfig map[string]string) error {
+	kafkaTopic, err := k.FetchKafkaTopic(types.NamespacedName{Name: topicName, Namespace: namespace})
+	if err != nil {
+		return err
+	} else if kafkaTopic == nil {
+		return fmt.Errorf("kafka topic %s not found in namespace %s", topicName, namespace)
+	}
+
+	var mismatches []string
+	for key, expectedValue := range expectedConfig {
+		if value, found := kafkaTopic.Spec.Config[key]; !found {
+			mismatches = append(mismatches, fmt.Sprintf("%s is not set, expected %s", key, expectedValue))
+		} else if value != expectedValue {
+			mismatches = append(mismatches, fmt.Sprintf("%s is %s, expected %s", key, value, expectedValue))
+		}
+	}
+	if len(mismatches) > 0 {
+		sort.Strings(mismatches)
+		return fmt.Errorf("kafka topic %s config doesn't match: %s", topicName, strings.Join(mismatches, "; "))
+	}
+	return nil
+}
+
+// WaitForKafkaTopicDeleted waits until the given kafka topic doesn't exist anymore
+func (k *kafkaHandler) WaitForKafkaTopicDeleted(namespace, topicName string, timeoutInMin int) error {
+	return k.waitForKafkaTopicDeleted(types.NamespacedName{Name: topicName, Namespace: namespace}, time.Duration(timeoutInMin)*time.Minute)
+}
+
+func (k *kafkaHandler) waitForKafkaTopicDeleted(key types.NamespacedName, timeout time.Duration) error {
+	err := wait.PollImmediate(kafkaTopicDeletedPollInterval, timeout, func() (bool, error) {
+		kafkaTopic, err := k.FetchKafkaTopic(key)
+		if err != nil {
+			return false, err
+		}
+		return kafkaTopic == nil, nil
+	})
+	if err != nil {
+		return fmt.Errorf("kafka topic %s not deleted from namespace %s: %v", key.Name, key.Namespace, err)
+	}
+	return nil
+}
+
+func (k *kafkaHandler) FetchKafkaUser(key types.NamespacedName) (*v1beta2.KafkaUser, error) {
+	k.Log.Debug("Going to load deployed kafka user", "userName", key.Name)
+	kafkaUser := &v1beta2.KafkaUser{}
+	if exists, err := kubernetes.ResourceC(k.Client).FetchWithKey(key, kafkaUser); err != nil {
+		k.Log.Error(err, "Error occurs while fetching kafka user", "userName", key.Name)
+		return nil, err
+	} else if exists {
+		k.Log.Debug("kafka user found", "userName", key.Name)
+		return kafkaUser, nil
+	}
+	k.Log.Debug("kafka user not exists", "userName", key.Name)
+	return nil, nil
+}
+
+func (k *kafkaHandler) FetchKafkaUsersByLabel(namespace string, labels map[string]string) ([]v1beta2.KafkaUser, error) {
+	k.Log.Debug("Going to load deployed kafka users", "labels", labels)
+	kafkaUsers := &v1beta2.KafkaUserList{}
+	if err := kubernetes.ResourceC(k.Client).ListWithNamespaceAndLabel(namespace, kafkaUsers, labels); err != nil {
+		k.Log.Error(err, "Error occurs while listing kafka users", "labels", labels)
+		return nil, err
+	}
+	k.Log.Debug("kafka users found", "count", len(kafkaUsers.Items))
+	return kafkaUsers.Items, nil
+}
+
 func (k *kafkaHandler) CreateKafkaTopic(topicName, kafkaName, kafkaNamespace string) (*v1beta2.KafkaTopic, error) {
 	k.Log.Debug("Going to create kafka topic", "topicName", topicName)
 	kafkaTopic := getKafkaTopic(topicName, kafkaNamespace, kafkaName)
@@ -107,6 +480,110 @@ func (k *kafkaHandler) CreateKafkaTopic(topicName, kafkaName, kafkaNamespace str
 	return kafkaTopic, nil
 }
 
+// DeleteKafkaInstance deletes the kafka instance and, if deleteTopics is set, all kafka topics referencing it
+func (k *kafkaHandler) DeleteKafkaInstance(key types.NamespacedName, deleteTopics bool) error {
+	kafkaInstance, err := k.FetchKafkaInstance(key)
+	if err != nil {
+		return err
+	} else if kafkaInstance == nil {
+		return nil
+	}
+
+	if deleteTopics {
+		kafkaTopics := &v1beta2.KafkaTopicList{}
+		if err := kubernetes.ResourceC(k.Client).ListWithNamespaceAndLabel(key.Namespace, kafkaTopics, map[string]string{strimziBrokerLabel: key.Name}); err != nil {
+			k.Log.Error(err, "Error occurs while listing kafka topics", "kafka instance", key.Name)
+			return err
+		}
+		for i := range kafkaTopics.Items {
+			k.Log.Debug("Going to delete kafka topic", "topicName", kafkaTopics.Items[i].Name)
+			if err := kubernetes.ResourceC(k.Client).Delete(&kafkaTopics.Items[i]); err != nil {
+				k.Log.Error(err, "Error occurs while deleting kafka topic", "topicName", kafkaTopics.Items[i].Name)
+				return err
+			}
+		}
+	}
+
+	k.Log.Debug("Going to delete kafka instance", "kafka instance", key.Name)
+	if err := kubernetes.ResourceC(k.Client).Delete(kafkaInstance); err != nil {
+		k.Log.Error(err, "Error occurs while deleting kafka instance", "kafka instance", key.Name)
+		return err
+	}
+	return nil
+}
+
+func (k *kafkaHandler) FetchKafkaConnect(key types.NamespacedName) (*v1beta2.KafkaConnect, error) {
+	k.Log.Debug("Going to load deployed kafka connect", "kafka connect", key.Name)
+	kafkaConnect := &v1beta2.KafkaConnect{}
+	if exists, err := kubernetes.ResourceC(k.Client).FetchWithKey(key, kafkaConnect); err != nil {
+		k.Log.Error(err, "Error occurs while fetching kafka connect", "kafka connect", key.Name)
+		return nil, err
+	} else if exists {
+		k.Log.Debug("kafka connect found", "kafka connect", key.Name)
+		return kafkaConnect, nil
+	}
+	k.Log.Debug("kafka connect not exists", "kafka connect", key.Name)
+	return nil, nil
+}
+
+// CreateKafkaConnectIfNotExists creates a kafka connect cluster connected to the plain listener of the given kafka instance
+func (k *kafkaHandler) CreateKafkaConnectIfNotExists(namespace, name, kafkaInstanceName string, replicas int32) error {
+	k.Log.Debug("Going to create kafka connect", "kafka connect", name, "kafka instance", kafkaInstanceName)
+	kafkaConnect := &v1beta2.KafkaConnect{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: v1beta2.KafkaConnectSpec{
+			Replicas:         replicas,
+			BootstrapServers: fmt.Sprintf("%s%s:%d", kafkaInstanceName, kafkaBootstrapServiceSuffix, kafkaPlainListenerPort),
+		},
+	}
+	if err := kubernetes.ResourceC(k.Client).CreateIfNotExists(kafkaConnect); err != nil {
+		k.Log.Error(err, "Error occurs while creating kafka connect", "kafka connect", name)
+		return err
+	}
+	return nil
+}
+
+// WaitForKafkaConnectReady waits until the given kafka connect cluster has the ready condition set
+func (k *kafkaHandler) WaitForKafkaConnectReady(namespace, name string, timeoutInMin int) error {
+	key := types.NamespacedName{Name: name, Namespace: namespace}
+	err := wait.PollImmediate(kafkaReadyPollInterval, time.Duration(timeoutInMin)*time.Minute, func() (bool, error) {
+		kafkaConnect, err := k.FetchKafkaConnect(key)
+		if err != nil || kafkaConnect == nil {
+			return false, err
+		}
+		for _, condition := range kafkaConnect.Status.Conditions {
+			if condition.Type == v1beta2.KafkaConditionTypeReady {
+				return condition.Status == corev1.ConditionTrue, nil
+			}
+		}
+		return false, nil
+	})
+	if err != nil {
+		return fmt.Errorf("kafka connect %s not ready in namespace %s: %v", name, namespace, err)
+	}
+	return nil
+}
+
+// DeleteKafkaConnect deletes the given kafka connect cluster if it exists
+func (k *kafkaHandler) DeleteKafkaConnect(namespace, name string) error {
+	kafkaConnect, err := k.FetchKafkaConnect(types.NamespacedName{Name: name, Namespace: namespace})
+	if err != nil {
+		return err
+	} else if kafkaConnect == nil {
+		return nil
+	}
+
+	k.Log.Debug("Going to delete kafka connect", "kafka connect", name)
+	if err := kubernetes.ResourceC(k.Client).Delete(kafkaConnect); err != nil {
+		k.Log.Error(err, "Error occurs while deleting kafka connect", "kafka connect", name)
+		return err
+	}
+	return nil
+}
+
 // getKafkaTopic returns a Kafka topic resource with default configuration
 func getKafkaTopic(name, namespace, kafkaBroker string) *v1beta2.KafkaTopic {
 
@@ -147,6 +624,59 @@ func (k *kafkaHandler) ResolveKafkaServerURI(kafka *v1beta2.Kafka) (string, erro
 	return "", fmt.Errorf("not able resolve URI for given kafka instance %s", kafka.Name)
 }
 
+// GetKafkaBootstrapServers returns the comma separated list of all addresses of the given listener type of the kafka instance
+func (k *kafkaHandler) GetKafkaBootstrapServers(kafka *v1beta2.Kafka, listenerType string) (string, error) {
+	k.Log.Debug("Resolving kafka bootstrap servers", "kafka instance", kafka.Name, "listener type", listenerType)
+	var bootstrapServers []string
+	for _, listenerStatus := range kafka.Status.Listeners {
+		if listenerStatus.Type != listenerType {
+			continue
+		}
+		for _, listenerAddress := range listenerStatus.Addresses {
+			if len(listenerAddress.Host) > 0 && listenerAddress.Port > 0 {
+				bootstrapServers = append(bootstrapServers, fmt.Sprintf("%s:%d", listenerAddress.Host, listenerAddress.Port))
+			}
+		}
+	}
+	if len(bootstrapServers) == 0 {
+		return "", fmt.Errorf("not able resolve bootstrap servers of listener type %s for given kafka instance %s", listenerType, kafka.Name)
+	}
+	return strings.Join(bootstrapServers, ","), nil
+}
+
+// GetKafkaExternalListenerBootstrap returns the first bootstrap address of the external listener of the given kafka instance
+func (k *kafkaHandler) GetKafkaExternalListenerBootstrap(key types.NamespacedName) (string, error) {
+	kafkaInstance, err := k.FetchKafkaInstance(key)
+	if err != nil {
+		return "", err
+	} else if kafkaInstance == nil {
+		return "", fmt.Errorf("kafka instance %s not found in namespace %s", key.Name, key.Namespace)
+	}
+
+	for _, listenerStatus := range kafkaInstance.Status.Listeners {
+		if listenerStatus.Type != kafkaExternalListenerType {
+			continue
+		}
+		for _, listenerAddress := range listenerStatus.Addresses {
+			if len(listenerAddress.Host) > 0 && listenerAddress.Port > 0 {
+				return fmt.Sprintf("%s:%d", listenerAddress.Host, listenerAddress.Port), nil
+			}
+		}
+	}
+	return "", fmt.Errorf("not able to resolve external listener bootstrap address for given kafka instance %s", key.Name)
+}
+
+// IsKafkaTLSEnabled returns true if at least one listener of the given kafka instance has TLS enabled
+func (k *kafkaHandler) IsKafkaTLSEnabled(kafka *v1beta2.Kafka) bool {
+	for _, listener := range kafka.Spec.Kafka.Listeners {
+		if listener.TLS {
+			k.Log.Debug("TLS enabled listener found", "kafka instance", kafka.Name, "listener", listener.Name)
+			return true
+		}
+	}
+	return false
+}
+
 // IsKafkaResource checks if provided KogitoInfra instance is for kafka resource
 func IsKafkaResource(apiVersion, kind string) bool {
 	return apiVersion == KafkaAPIVersion && kind == KafkaKind
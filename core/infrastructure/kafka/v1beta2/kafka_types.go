@@ -53,6 +53,7 @@ func (kafkaMap *KafkaMap) DeepCopy() *KafkaMap {
 
 // KafkaClusterSpec defines the desired state of Kafka Cluster
 type KafkaClusterSpec struct {
+	Version    string                 `json:"version,omitempty"`
 	Replicas   int32                  `json:"replicas,omitempty"`
 	Listeners  []GenericKafkaListener `json:"listeners,omitempty"`
 	Storage    KafkaStorage           `json:"storage,omitempty"`
@@ -89,8 +90,9 @@ const (
 
 // KafkaStatus defines the observed state of Kafka
 type KafkaStatus struct {
-	Listeners  []ListenerStatus `json:"listeners,omitempty"`
-	Conditions []KafkaCondition `json:"conditions,omitempty"`
+	Listeners          []ListenerStatus `json:"listeners,omitempty"`
+	Conditions         []KafkaCondition `json:"conditions,omitempty"`
+	ObservedGeneration int64            `json:"observedGeneration,omitempty"`
 }
 
 // KafkaCondition conditions for a Kafka resource
@@ -114,7 +116,10 @@ const (
 // ListenerStatus defines a single listener
 type ListenerStatus struct {
 	Type      string            `json:"type,omitempty"`
+	Name      string            `json:"name,omitempty"`
 	Addresses []ListenerAddress `json:"addresses,omitempty"`
+	// Certificates are the PEM encoded TLS certificates used to connect to the listener
+	Certificates []string `json:"certificates,omitempty"`
 }
 
 // ListenerAddress defines a single address of particular listener
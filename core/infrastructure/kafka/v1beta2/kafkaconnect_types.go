@@ -0,0 +1,55 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// KafkaConnectSpec defines the desired state of KafkaConnect
+type KafkaConnectSpec struct {
+	Replicas         int32             `json:"replicas,omitempty"`
+	BootstrapServers string            `json:"bootstrapServers"`
+	Config           map[string]string `json:"config,omitempty"`
+}
+
+// KafkaConnectStatus defines the observed state of KafkaConnect
+type KafkaConnectStatus struct {
+	URL                string           `json:"url,omitempty"`
+	Conditions         []KafkaCondition `json:"conditions,omitempty"`
+	ObservedGeneration int64            `json:"observedGeneration,omitempty"`
+}
+
+// KafkaConnect is the Schema for the kafkaconnects API
+// +kubebuilder:object:root=true
+type KafkaConnect struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   KafkaConnectSpec   `json:"spec,omitempty"`
+	Status KafkaConnectStatus `json:"status,omitempty"`
+}
+
+// KafkaConnectList contains a list of KafkaConnect
+// +kubebuilder:object:root=true
+type KafkaConnectList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KafkaConnect `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&KafkaConnect{}, &KafkaConnectList{})
+}
@@ -22,6 +22,37 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ACLRule) DeepCopyInto(out *ACLRule) {
+	*out = *in
+	out.Resource = in.Resource
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ACLRule.
+func (in *ACLRule) DeepCopy() *ACLRule {
+	if in == nil {
+		return nil
+	}
+	out := new(ACLRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ACLRuleResource) DeepCopyInto(out *ACLRuleResource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ACLRuleResource.
+func (in *ACLRuleResource) DeepCopy() *ACLRuleResource {
+	if in == nil {
+		return nil
+	}
+	out := new(ACLRuleResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EntityOperatorSpec) DeepCopyInto(out *EntityOperatorSpec) {
 	*out = *in
@@ -149,6 +180,107 @@ func (in *KafkaCondition) DeepCopy() *KafkaCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaConnect) DeepCopyInto(out *KafkaConnect) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnect.
+func (in *KafkaConnect) DeepCopy() *KafkaConnect {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaConnect)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaConnect) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaConnectList) DeepCopyInto(out *KafkaConnectList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KafkaConnect, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectList.
+func (in *KafkaConnectList) DeepCopy() *KafkaConnectList {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaConnectList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaConnectList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaConnectSpec) DeepCopyInto(out *KafkaConnectSpec) {
+	*out = *in
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectSpec.
+func (in *KafkaConnectSpec) DeepCopy() *KafkaConnectSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaConnectSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaConnectStatus) DeepCopyInto(out *KafkaConnectStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]KafkaCondition, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectStatus.
+func (in *KafkaConnectStatus) DeepCopy() *KafkaConnectStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaConnectStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KafkaList) DeepCopyInto(out *KafkaList) {
 	*out = *in
@@ -346,6 +478,137 @@ func (in *KafkaTopicStatus) DeepCopy() *KafkaTopicStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaUser) DeepCopyInto(out *KafkaUser) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaUser.
+func (in *KafkaUser) DeepCopy() *KafkaUser {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaUser)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaUser) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaUserAuthentication) DeepCopyInto(out *KafkaUserAuthentication) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaUserAuthentication.
+func (in *KafkaUserAuthentication) DeepCopy() *KafkaUserAuthentication {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaUserAuthentication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaUserAuthorization) DeepCopyInto(out *KafkaUserAuthorization) {
+	*out = *in
+	if in.ACLs != nil {
+		in, out := &in.ACLs, &out.ACLs
+		*out = make([]ACLRule, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaUserAuthorization.
+func (in *KafkaUserAuthorization) DeepCopy() *KafkaUserAuthorization {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaUserAuthorization)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaUserList) DeepCopyInto(out *KafkaUserList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KafkaUser, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaUserList.
+func (in *KafkaUserList) DeepCopy() *KafkaUserList {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaUserList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaUserList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaUserSpec) DeepCopyInto(out *KafkaUserSpec) {
+	*out = *in
+	out.Authentication = in.Authentication
+	in.Authorization.DeepCopyInto(&out.Authorization)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaUserSpec.
+func (in *KafkaUserSpec) DeepCopy() *KafkaUserSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaUserSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaUserStatus) DeepCopyInto(out *KafkaUserStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]KafkaCondition, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaUserStatus.
+func (in *KafkaUserStatus) DeepCopy() *KafkaUserStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaUserStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ListenerAddress) DeepCopyInto(out *ListenerAddress) {
 	*out = *in
@@ -369,6 +632,11 @@ func (in *ListenerStatus) DeepCopyInto(out *ListenerStatus) {
 		*out = make([]ListenerAddress, len(*in))
 		copy(*out, *in)
 	}
+	if in.Certificates != nil {
+		in, out := &in.Certificates, &out.Certificates
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerStatus.
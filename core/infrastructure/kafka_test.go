@@ -16,14 +16,18 @@ package infrastructure
 
 import (
 	"github.com/kiegroup/kogito-operator/core/client"
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
 	"github.com/kiegroup/kogito-operator/core/infrastructure/kafka/v1beta2"
 	"github.com/kiegroup/kogito-operator/core/operator"
 	"github.com/kiegroup/kogito-operator/core/test"
 	"github.com/kiegroup/kogito-operator/meta"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func Test_getKafkaInstanceWithName(t *testing.T) {
@@ -165,3 +169,872 @@ func Test_resolveKafkaServerURI(t *testing.T) {
 		})
 	}
 }
+
+func Test_getKafkaBootstrapServers(t *testing.T) {
+	kafka := &v1beta2.Kafka{
+		ObjectMeta: v1.ObjectMeta{Name: "kafka"},
+		Status: v1beta2.KafkaStatus{
+			Listeners: []v1beta2.ListenerStatus{
+				{
+					Type: "tls",
+					Addresses: []v1beta2.ListenerAddress{
+						{Host: "kafka-tls", Port: 9093},
+					},
+				},
+				{
+					Type: "plain",
+					Addresses: []v1beta2.ListenerAddress{
+						{Host: "kafka-0", Port: 9092},
+						{Host: "kafka-1", Port: 9092},
+					},
+				},
+			},
+		},
+	}
+	cli := test.NewFakeClientBuilder().Build()
+	context := &operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	kafkaHandler := NewKafkaHandler(context)
+
+	bootstrapServers, err := kafkaHandler.GetKafkaBootstrapServers(kafka, "plain")
+	assert.NoError(t, err)
+	assert.Equal(t, "kafka-0:9092,kafka-1:9092", bootstrapServers)
+
+	bootstrapServers, err = kafkaHandler.GetKafkaBootstrapServers(kafka, "external")
+	assert.Error(t, err)
+	assert.Empty(t, bootstrapServers)
+}
+
+func Test_getKafkaExternalListenerBootstrap(t *testing.T) {
+	ns := t.Name()
+
+	kafka := &v1beta2.Kafka{
+		ObjectMeta: v1.ObjectMeta{Name: "kafka", Namespace: ns},
+		Status: v1beta2.KafkaStatus{
+			Listeners: []v1beta2.ListenerStatus{
+				{
+					Type: "plain",
+					Addresses: []v1beta2.ListenerAddress{
+						{Host: "kafka-0", Port: 9092},
+					},
+				},
+				{
+					Type: "external",
+					Addresses: []v1beta2.ListenerAddress{
+						{Host: "192.168.1.10", Port: 31234},
+						{Host: "192.168.1.11", Port: 31234},
+					},
+				},
+			},
+		},
+	}
+	internalKafka := &v1beta2.Kafka{
+		ObjectMeta: v1.ObjectMeta{Name: "internal-kafka", Namespace: ns},
+		Status: v1beta2.KafkaStatus{
+			Listeners: []v1beta2.ListenerStatus{
+				{
+					Type: "plain",
+					Addresses: []v1beta2.ListenerAddress{
+						{Host: "internal-kafka-0", Port: 9092},
+					},
+				},
+			},
+		},
+	}
+
+	cli := test.NewFakeClientBuilder().AddK8sObjects(kafka, internalKafka).Build()
+	context := &operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	kafkaHandler := NewKafkaHandler(context)
+
+	bootstrap, err := kafkaHandler.GetKafkaExternalListenerBootstrap(types.NamespacedName{Name: "kafka", Namespace: ns})
+	assert.NoError(t, err)
+	assert.Equal(t, "192.168.1.10:31234", bootstrap)
+
+	bootstrap, err = kafkaHandler.GetKafkaExternalListenerBootstrap(types.NamespacedName{Name: "internal-kafka", Namespace: ns})
+	assert.Error(t, err)
+	assert.Empty(t, bootstrap)
+}
+
+func Test_isKafkaTLSEnabled(t *testing.T) {
+	type args struct {
+		kafka *v1beta2.Kafka
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			"TLSListenerPresent",
+			args{
+				&v1beta2.Kafka{
+					Spec: v1beta2.KafkaSpec{
+						Kafka: v1beta2.KafkaClusterSpec{
+							Listeners: []v1beta2.GenericKafkaListener{
+								{
+									Name:         "plain",
+									Port:         9092,
+									TLS:          false,
+									ListenerType: "internal",
+								},
+								{
+									Name:         "tls",
+									Port:         9093,
+									TLS:          true,
+									ListenerType: "internal",
+								},
+							},
+						},
+					},
+				},
+			},
+			true,
+		},
+		{
+			"OnlyPlainListener",
+			args{
+				&v1beta2.Kafka{
+					Spec: v1beta2.KafkaSpec{
+						Kafka: v1beta2.KafkaClusterSpec{
+							Listeners: []v1beta2.GenericKafkaListener{
+								{
+									Name:         "plain",
+									Port:         9092,
+									TLS:          false,
+									ListenerType: "internal",
+								},
+							},
+						},
+					},
+				},
+			},
+			false,
+		},
+		{
+			"NoListeners",
+			args{
+				&v1beta2.Kafka{},
+			},
+			false,
+		},
+	}
+	cli := test.NewFakeClientBuilder().Build()
+	context := &operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	kafkaHandler := NewKafkaHandler(context)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := kafkaHandler.IsKafkaTLSEnabled(tt.args.kafka); got != tt.want {
+				t.Errorf("IsKafkaTLSEnabled() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_fetchKafkaUser(t *testing.T) {
+	ns := t.Name()
+
+	kafkaUser := &v1beta2.KafkaUser{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "kafka-user",
+			Namespace: ns,
+			Labels:    map[string]string{strimziBrokerLabel: "kafka"},
+		},
+		Spec: v1beta2.KafkaUserSpec{
+			Authentication: v1beta2.KafkaUserAuthentication{AuthenticationType: "tls"},
+		},
+	}
+
+	cli := test.NewFakeClientBuilder().AddK8sObjects(kafkaUser).Build()
+	context := &operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	kafkaHandler := NewKafkaHandler(context)
+
+	got, err := kafkaHandler.FetchKafkaUser(types.NamespacedName{Name: "kafka-user", Namespace: ns})
+	assert.NoError(t, err)
+	assert.NotNil(t, got)
+	assert.Equal(t, "tls", got.Spec.Authentication.AuthenticationType)
+
+	got, err = kafkaHandler.FetchKafkaUser(types.NamespacedName{Name: "not-existing", Namespace: ns})
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+
+	users, err := kafkaHandler.FetchKafkaUsersByLabel(ns, map[string]string{strimziBrokerLabel: "kafka"})
+	assert.NoError(t, err)
+	assert.Len(t, users, 1)
+
+	users, err = kafkaHandler.FetchKafkaUsersByLabel(ns, map[string]string{strimziBrokerLabel: "other"})
+	assert.NoError(t, err)
+	assert.Empty(t, users)
+}
+
+func Test_listKafkaInstancesByLabel(t *testing.T) {
+	ns := t.Name()
+
+	kafkaApp1 := &v1beta2.Kafka{
+		ObjectMeta: v1.ObjectMeta{Name: "kafka-app1", Namespace: ns, Labels: map[string]string{"app": "app1"}},
+	}
+	kafkaApp1Bis := &v1beta2.Kafka{
+		ObjectMeta: v1.ObjectMeta{Name: "kafka-app1-bis", Namespace: ns, Labels: map[string]string{"app": "app1"}},
+	}
+	kafkaApp2 := &v1beta2.Kafka{
+		ObjectMeta: v1.ObjectMeta{Name: "kafka-app2", Namespace: ns, Labels: map[string]string{"app": "app2"}},
+	}
+	kafkaNoLabel := &v1beta2.Kafka{
+		ObjectMeta: v1.ObjectMeta{Name: "kafka", Namespace: ns},
+	}
+
+	cli := test.NewFakeClientBuilder().AddK8sObjects(kafkaApp1, kafkaApp1Bis, kafkaApp2, kafkaNoLabel).Build()
+	context := &operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	kafkaHandler := NewKafkaHandler(context)
+
+	kafkaInstances, err := kafkaHandler.ListKafkaInstancesByLabel(ns, map[string]string{"app": "app1"})
+	assert.NoError(t, err)
+	assert.Len(t, kafkaInstances, 2)
+	for _, kafka := range kafkaInstances {
+		assert.Equal(t, "app1", kafka.Labels["app"])
+	}
+
+	kafkaInstances, err = kafkaHandler.ListKafkaInstancesByLabel(ns, map[string]string{"app": "app3"})
+	assert.NoError(t, err)
+	assert.Empty(t, kafkaInstances)
+}
+
+func Test_fetchKafkaInstanceStatus(t *testing.T) {
+	ns := t.Name()
+
+	kafka := &v1beta2.Kafka{
+		ObjectMeta: v1.ObjectMeta{Name: "kafka", Namespace: ns},
+		Status: v1beta2.KafkaStatus{
+			Conditions: []v1beta2.KafkaCondition{
+				{
+					Type:   "Ready",
+					Status: "True",
+				},
+			},
+		},
+	}
+
+	cli := test.NewFakeClientBuilder().AddK8sObjects(kafka).Build()
+	context := &operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	kafkaHandler := NewKafkaHandler(context)
+
+	status, err := kafkaHandler.FetchKafkaInstanceStatus(types.NamespacedName{Name: "kafka", Namespace: ns})
+	assert.NoError(t, err)
+	assert.NotNil(t, status)
+	assert.Len(t, status.Conditions, 1)
+	assert.Equal(t, "Ready", status.Conditions[0].Type)
+
+	status, err = kafkaHandler.FetchKafkaInstanceStatus(types.NamespacedName{Name: "not-existing", Namespace: ns})
+	assert.NoError(t, err)
+	assert.Nil(t, status)
+}
+
+func Test_deleteKafkaInstance(t *testing.T) {
+	ns := t.Name()
+
+	kafka := &v1beta2.Kafka{
+		ObjectMeta: v1.ObjectMeta{Name: "kafka", Namespace: ns},
+	}
+	kafkaTopic := &v1beta2.KafkaTopic{
+		ObjectMeta: v1.ObjectMeta{Name: "topic", Namespace: ns, Labels: map[string]string{strimziBrokerLabel: "kafka"}},
+	}
+	otherKafkaTopic := &v1beta2.KafkaTopic{
+		ObjectMeta: v1.ObjectMeta{Name: "other-topic", Namespace: ns, Labels: map[string]string{strimziBrokerLabel: "other-kafka"}},
+	}
+
+	cli := test.NewFakeClientBuilder().AddK8sObjects(kafka, kafkaTopic, otherKafkaTopic).Build()
+	context := &operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	kafkaHandler := NewKafkaHandler(context)
+
+	err := kafkaHandler.DeleteKafkaInstance(types.NamespacedName{Name: "kafka", Namespace: ns}, true)
+	assert.NoError(t, err)
+
+	deletedKafka, err := kafkaHandler.FetchKafkaInstance(types.NamespacedName{Name: "kafka", Namespace: ns})
+	assert.NoError(t, err)
+	assert.Nil(t, deletedKafka)
+	deletedTopic, err := kafkaHandler.FetchKafkaTopic(types.NamespacedName{Name: "topic", Namespace: ns})
+	assert.NoError(t, err)
+	assert.Nil(t, deletedTopic)
+	remainingTopic, err := kafkaHandler.FetchKafkaTopic(types.NamespacedName{Name: "other-topic", Namespace: ns})
+	assert.NoError(t, err)
+	assert.NotNil(t, remainingTopic)
+
+	// Deleting not existing instance is a no-op
+	err = kafkaHandler.DeleteKafkaInstance(types.NamespacedName{Name: "kafka", Namespace: ns}, false)
+	assert.NoError(t, err)
+}
+
+func Test_getKafkaClusterStatus(t *testing.T) {
+	ns := t.Name()
+
+	kafka := &v1beta2.Kafka{
+		ObjectMeta: v1.ObjectMeta{Name: "kafka", Namespace: ns},
+		Status: v1beta2.KafkaStatus{
+			ObservedGeneration: 2,
+			Conditions: []v1beta2.KafkaCondition{
+				{
+					Type:   v1beta2.KafkaConditionTypeReady,
+					Status: "True",
+				},
+			},
+			Listeners: []v1beta2.ListenerStatus{
+				{
+					Type: "plain",
+					Addresses: []v1beta2.ListenerAddress{
+						{Host: "kafka-0", Port: 9092},
+					},
+				},
+				{
+					Type: "tls",
+					Addresses: []v1beta2.ListenerAddress{
+						{Host: "kafka-0", Port: 9093},
+					},
+				},
+			},
+		},
+	}
+	notReadyKafka := &v1beta2.Kafka{
+		ObjectMeta: v1.ObjectMeta{Name: "not-ready-kafka", Namespace: ns},
+	}
+
+	cli := test.NewFakeClientBuilder().AddK8sObjects(kafka, notReadyKafka).Build()
+	context := &operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	kafkaHandler := NewKafkaHandler(context)
+
+	summary, err := kafkaHandler.GetKafkaClusterStatus(types.NamespacedName{Name: "kafka", Namespace: ns})
+	assert.NoError(t, err)
+	assert.Equal(t, &KafkaClusterSummary{IsReady: true, ObservedGeneration: 2, ListenerCount: 2, BootstrapServers: "kafka-0:9092"}, summary)
+
+	summary, err = kafkaHandler.GetKafkaClusterStatus(types.NamespacedName{Name: "not-ready-kafka", Namespace: ns})
+	assert.NoError(t, err)
+	assert.Equal(t, &KafkaClusterSummary{}, summary)
+
+	_, err = kafkaHandler.GetKafkaClusterStatus(types.NamespacedName{Name: "not-existing", Namespace: ns})
+	assert.Error(t, err)
+}
+
+func Test_getKafkaReplicaCount(t *testing.T) {
+	ns := t.Name()
+
+	kafka := &v1beta2.Kafka{
+		ObjectMeta: v1.ObjectMeta{Name: "kafka", Namespace: ns},
+		Spec: v1beta2.KafkaSpec{
+			Kafka: v1beta2.KafkaClusterSpec{Replicas: 3},
+		},
+	}
+
+	cli := test.NewFakeClientBuilder().AddK8sObjects(kafka).Build()
+	context := &operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	kafkaHandler := NewKafkaHandler(context)
+
+	replicas, err := kafkaHandler.GetKafkaReplicaCount(types.NamespacedName{Name: "kafka", Namespace: ns})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), replicas)
+
+	_, err = kafkaHandler.GetKafkaReplicaCount(types.NamespacedName{Name: "not-existing", Namespace: ns})
+	assert.Error(t, err)
+}
+
+func Test_getKafkaZookeeperReplicas(t *testing.T) {
+	ns := t.Name()
+
+	kafka := &v1beta2.Kafka{
+		ObjectMeta: v1.ObjectMeta{Name: "kafka", Namespace: ns},
+		Spec: v1beta2.KafkaSpec{
+			Kafka:     v1beta2.KafkaClusterSpec{Replicas: 1},
+			Zookeeper: v1beta2.ZookeeperClusterSpec{Replicas: 3},
+		},
+	}
+
+	cli := test.NewFakeClientBuilder().AddK8sObjects(kafka).Build()
+	context := &operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	kafkaHandler := NewKafkaHandler(context)
+
+	replicas, err := kafkaHandler.GetKafkaZookeeperReplicas(types.NamespacedName{Name: "kafka", Namespace: ns})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), replicas)
+
+	_, err = kafkaHandler.GetKafkaZookeeperReplicas(types.NamespacedName{Name: "not-existing", Namespace: ns})
+	assert.Error(t, err)
+}
+
+func Test_getKafkaListenerCount(t *testing.T) {
+	ns := t.Name()
+
+	kafka := &v1beta2.Kafka{
+		ObjectMeta: v1.ObjectMeta{Name: "kafka", Namespace: ns},
+		Spec: v1beta2.KafkaSpec{
+			Kafka: v1beta2.KafkaClusterSpec{
+				Listeners: []v1beta2.GenericKafkaListener{
+					{Name: "plain", Port: 9092, ListenerType: "internal"},
+					{Name: "tls", Port: 9093, TLS: true, ListenerType: "internal"},
+				},
+			},
+		},
+	}
+
+	cli := test.NewFakeClientBuilder().AddK8sObjects(kafka).Build()
+	context := &operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	kafkaHandler := NewKafkaHandler(context)
+
+	count, err := kafkaHandler.GetKafkaListenerCount(types.NamespacedName{Name: "kafka", Namespace: ns})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	_, err = kafkaHandler.GetKafkaListenerCount(types.NamespacedName{Name: "not-existing", Namespace: ns})
+	assert.Error(t, err)
+}
+
+func Test_getKafkaTopicPartitionCount(t *testing.T) {
+	ns := t.Name()
+
+	kafkaTopic := &v1beta2.KafkaTopic{
+		ObjectMeta: v1.ObjectMeta{Name: "kogito-topic", Namespace: ns},
+		Spec: v1beta2.KafkaTopicSpec{
+			Partitions: 3,
+			Replicas:   1,
+		},
+	}
+
+	cli := test.NewFakeClientBuilder().AddK8sObjects(kafkaTopic).Build()
+	context := &operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	kafkaHandler := NewKafkaHandler(context)
+
+	partitions, err := kafkaHandler.GetKafkaTopicPartitionCount(ns, "kogito-topic")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, partitions)
+
+	_, err = kafkaHandler.GetKafkaTopicPartitionCount(ns, "not-existing")
+	assert.Error(t, err)
+}
+
+func Test_getKafkaTopicRetentionMs(t *testing.T) {
+	ns := t.Name()
+
+	retentionTopic := &v1beta2.KafkaTopic{
+		ObjectMeta: v1.ObjectMeta{Name: "retention-topic", Namespace: ns},
+		Spec: v1beta2.KafkaTopicSpec{
+			Config: map[string]string{"retention.ms": "7200000"},
+		},
+	}
+	defaultTopic := &v1beta2.KafkaTopic{
+		ObjectMeta: v1.ObjectMeta{Name: "default-topic", Namespace: ns},
+	}
+	invalidTopic := &v1beta2.KafkaTopic{
+		ObjectMeta: v1.ObjectMeta{Name: "invalid-topic", Namespace: ns},
+		Spec: v1beta2.KafkaTopicSpec{
+			Config: map[string]string{"retention.ms": "two hours"},
+		},
+	}
+
+	cli := test.NewFakeClientBuilder().AddK8sObjects(retentionTopic, defaultTopic, invalidTopic).Build()
+	context := &operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	kafkaHandler := NewKafkaHandler(context)
+
+	retention, err := kafkaHandler.GetKafkaTopicRetentionMs(ns, "retention-topic")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7200000), retention)
+
+	retention, err = kafkaHandler.GetKafkaTopicRetentionMs(ns, "default-topic")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(KafkaTopicRetentionMsUnset), retention)
+
+	_, err = kafkaHandler.GetKafkaTopicRetentionMs(ns, "invalid-topic")
+	assert.Error(t, err)
+
+	_, err = kafkaHandler.GetKafkaTopicRetentionMs(ns, "not-existing")
+	assert.Error(t, err)
+}
+
+func Test_checkKafkaTopicConfigMatch(t *testing.T) {
+	ns := t.Name()
+
+	kafkaTopic := &v1beta2.KafkaTopic{
+		ObjectMeta: v1.ObjectMeta{Name: "kogito-topic", Namespace: ns},
+		Spec: v1beta2.KafkaTopicSpec{
+			Config: map[string]string{"retention.ms": "7200000", "cleanup.policy": "delete"},
+		},
+	}
+
+	cli := test.NewFakeClientBuilder().AddK8sObjects(kafkaTopic).Build()
+	context := &operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	kafkaHandler := NewKafkaHandler(context)
+
+	err := kafkaHandler.CheckKafkaTopicConfigMatch(ns, "kogito-topic", map[string]string{"retention.ms": "7200000"})
+	assert.NoError(t, err)
+
+	err = kafkaHandler.CheckKafkaTopicConfigMatch(ns, "kogito-topic", map[string]string{"retention.ms": "3600000", "segment.bytes": "1048576"})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "retention.ms is 7200000, expected 3600000")
+	assert.Contains(t, err.Error(), "segment.bytes is not set, expected 1048576")
+
+	err = kafkaHandler.CheckKafkaTopicConfigMatch(ns, "not-existing", map[string]string{})
+	assert.Error(t, err)
+}
+
+func Test_waitForKafkaTopicDeleted(t *testing.T) {
+	ns := t.Name()
+
+	kafkaTopic := &v1beta2.KafkaTopic{
+		ObjectMeta: v1.ObjectMeta{Name: "kogito-topic", Namespace: ns},
+	}
+
+	cli := test.NewFakeClientBuilder().AddK8sObjects(kafkaTopic).Build()
+	context := &operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	kafkaHandler := &kafkaHandler{context}
+	key := types.NamespacedName{Name: "kogito-topic", Namespace: ns}
+
+	err := kafkaHandler.waitForKafkaTopicDeleted(key, time.Second)
+	assert.Error(t, err)
+
+	go func() {
+		time.Sleep(time.Second)
+		assert.NoError(t, kubernetes.ResourceC(cli).Delete(kafkaTopic))
+	}()
+	err = kafkaHandler.WaitForKafkaTopicDeleted(ns, "kogito-topic", 1)
+	assert.NoError(t, err)
+}
+
+func Test_setKafkaSpecReplicas(t *testing.T) {
+	ns := t.Name()
+
+	kafka := &v1beta2.Kafka{
+		ObjectMeta: v1.ObjectMeta{Name: "kafka", Namespace: ns},
+		Spec: v1beta2.KafkaSpec{
+			Kafka: v1beta2.KafkaClusterSpec{Replicas: 1},
+		},
+		Status: v1beta2.KafkaStatus{
+			ObservedGeneration: 10,
+			Conditions: []v1beta2.KafkaCondition{
+				{
+					Type:   v1beta2.KafkaConditionTypeReady,
+					Status: "True",
+				},
+			},
+		},
+	}
+
+	cli := test.NewFakeClientBuilder().AddK8sObjects(kafka).Build()
+	context := &operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	kafkaHandler := NewKafkaHandler(context)
+	key := types.NamespacedName{Name: "kafka", Namespace: ns}
+
+	err := kafkaHandler.SetKafkaSpecReplicas(key, 3)
+	assert.NoError(t, err)
+
+	replicas, err := kafkaHandler.GetKafkaSpecReplicas(key)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), replicas)
+
+	err = kafkaHandler.SetKafkaSpecReplicas(types.NamespacedName{Name: "not-existing", Namespace: ns}, 3)
+	assert.Error(t, err)
+}
+
+func Test_waitForKafkaReady(t *testing.T) {
+	ns := t.Name()
+
+	kafka := &v1beta2.Kafka{
+		ObjectMeta: v1.ObjectMeta{Name: "kafka", Namespace: ns},
+		Status: v1beta2.KafkaStatus{
+			Conditions: []v1beta2.KafkaCondition{
+				{
+					Type:   v1beta2.KafkaConditionTypeReady,
+					Status: "True",
+				},
+			},
+			Listeners: []v1beta2.ListenerStatus{
+				{
+					Type:      "plain",
+					Addresses: []v1beta2.ListenerAddress{{Host: "kafka-kafka-bootstrap", Port: 9092}},
+				},
+			},
+		},
+	}
+	endpoints := &corev1.Endpoints{
+		ObjectMeta: v1.ObjectMeta{Name: "kafka-kafka-bootstrap", Namespace: ns},
+		Subsets: []corev1.EndpointSubset{
+			{
+				Addresses: []corev1.EndpointAddress{{IP: "10.0.0.1"}},
+			},
+		},
+	}
+
+	cli := test.NewFakeClientBuilder().AddK8sObjects(kafka, endpoints).Build()
+	context := &operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	kafkaHandler := NewKafkaHandler(context)
+
+	err := kafkaHandler.WaitForKafkaReady(ns, "kafka", 1)
+	assert.NoError(t, err)
+}
+
+func Test_waitForKafkaListenerStatusPopulated(t *testing.T) {
+	ns := t.Name()
+
+	kafka := &v1beta2.Kafka{
+		ObjectMeta: v1.ObjectMeta{Name: "kafka", Namespace: ns},
+		Status: v1beta2.KafkaStatus{
+			Listeners: []v1beta2.ListenerStatus{
+				{
+					Type: "tls",
+				},
+				{
+					Type:      "plain",
+					Addresses: []v1beta2.ListenerAddress{{Host: "kafka-kafka-bootstrap", Port: 9092}},
+				},
+			},
+		},
+	}
+
+	cli := test.NewFakeClientBuilder().AddK8sObjects(kafka).Build()
+	context := &operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	kafkaHandler := NewKafkaHandler(context)
+
+	err := kafkaHandler.WaitForKafkaListenerStatusPopulated(types.NamespacedName{Name: "kafka", Namespace: ns}, 1)
+	assert.NoError(t, err)
+}
+
+func Test_isKafkaReachable(t *testing.T) {
+	ns := t.Name()
+
+	readyCondition := []v1beta2.KafkaCondition{
+		{
+			Type:   v1beta2.KafkaConditionTypeReady,
+			Status: "True",
+		},
+	}
+	readyKafka := &v1beta2.Kafka{
+		ObjectMeta: v1.ObjectMeta{Name: "ready-kafka", Namespace: ns},
+		Status:     v1beta2.KafkaStatus{Conditions: readyCondition},
+	}
+	notReadyKafka := &v1beta2.Kafka{
+		ObjectMeta: v1.ObjectMeta{Name: "not-ready-kafka", Namespace: ns},
+		Status: v1beta2.KafkaStatus{
+			Conditions: []v1beta2.KafkaCondition{
+				{
+					Type:   v1beta2.KafkaConditionTypeReady,
+					Status: "False",
+				},
+			},
+		},
+	}
+	noEndpointsKafka := &v1beta2.Kafka{
+		ObjectMeta: v1.ObjectMeta{Name: "no-endpoints-kafka", Namespace: ns},
+		Status:     v1beta2.KafkaStatus{Conditions: readyCondition},
+	}
+	emptyEndpointsKafka := &v1beta2.Kafka{
+		ObjectMeta: v1.ObjectMeta{Name: "empty-endpoints-kafka", Namespace: ns},
+		Status:     v1beta2.KafkaStatus{Conditions: readyCondition},
+	}
+	readyEndpoints := &corev1.Endpoints{
+		ObjectMeta: v1.ObjectMeta{Name: "ready-kafka-kafka-bootstrap", Namespace: ns},
+		Subsets: []corev1.EndpointSubset{
+			{
+				Addresses: []corev1.EndpointAddress{{IP: "10.0.0.1"}},
+			},
+		},
+	}
+	notReadyEndpoints := &corev1.Endpoints{
+		ObjectMeta: v1.ObjectMeta{Name: "not-ready-kafka-kafka-bootstrap", Namespace: ns},
+		Subsets: []corev1.EndpointSubset{
+			{
+				Addresses: []corev1.EndpointAddress{{IP: "10.0.0.2"}},
+			},
+		},
+	}
+	emptyEndpoints := &corev1.Endpoints{
+		ObjectMeta: v1.ObjectMeta{Name: "empty-endpoints-kafka-kafka-bootstrap", Namespace: ns},
+		Subsets: []corev1.EndpointSubset{
+			{
+				NotReadyAddresses: []corev1.EndpointAddress{{IP: "10.0.0.3"}},
+			},
+		},
+	}
+
+	cli := test.NewFakeClientBuilder().AddK8sObjects(readyKafka, notReadyKafka, noEndpointsKafka, emptyEndpointsKafka, readyEndpoints, notReadyEndpoints, emptyEndpoints).Build()
+	context := &operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	kafkaHandler := &kafkaHandler{context}
+
+	tests := []struct {
+		name         string
+		instanceName string
+		want         bool
+	}{
+		{"Ready", "ready-kafka", true},
+		{"NotReadyCondition", "not-ready-kafka", false},
+		{"MissingEndpoints", "no-endpoints-kafka", false},
+		{"NoReadyAddresses", "empty-endpoints-kafka", false},
+		{"MissingInstance", "not-existing", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := kafkaHandler.isKafkaReachable(types.NamespacedName{Name: tt.instanceName, Namespace: ns})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_kafkaConnectLifecycle(t *testing.T) {
+	ns := t.Name()
+
+	readyKafkaConnect := &v1beta2.KafkaConnect{
+		ObjectMeta: v1.ObjectMeta{Name: "ready-connect", Namespace: ns},
+		Status: v1beta2.KafkaConnectStatus{
+			Conditions: []v1beta2.KafkaCondition{
+				{
+					Type:   v1beta2.KafkaConditionTypeReady,
+					Status: "True",
+				},
+			},
+		},
+	}
+
+	cli := test.NewFakeClientBuilder().AddK8sObjects(readyKafkaConnect).Build()
+	context := &operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	kafkaHandler := NewKafkaHandler(context)
+
+	err := kafkaHandler.CreateKafkaConnectIfNotExists(ns, "connect", "kafka", 2)
+	assert.NoError(t, err)
+	kafkaConnect, err := kafkaHandler.FetchKafkaConnect(types.NamespacedName{Name: "connect", Namespace: ns})
+	assert.NoError(t, err)
+	assert.NotNil(t, kafkaConnect)
+	assert.Equal(t, int32(2), kafkaConnect.Spec.Replicas)
+	assert.Equal(t, "kafka-kafka-bootstrap:9092", kafkaConnect.Spec.BootstrapServers)
+
+	err = kafkaHandler.WaitForKafkaConnectReady(ns, "ready-connect", 1)
+	assert.NoError(t, err)
+
+	err = kafkaHandler.DeleteKafkaConnect(ns, "connect")
+	assert.NoError(t, err)
+	kafkaConnect, err = kafkaHandler.FetchKafkaConnect(types.NamespacedName{Name: "connect", Namespace: ns})
+	assert.NoError(t, err)
+	assert.Nil(t, kafkaConnect)
+
+	err = kafkaHandler.DeleteKafkaConnect(ns, "not-existing")
+	assert.NoError(t, err)
+}
+
+func Test_kafkaClusterVersion(t *testing.T) {
+	ns := t.Name()
+
+	kafka := &v1beta2.Kafka{
+		ObjectMeta: v1.ObjectMeta{Name: "kafka", Namespace: ns},
+		Spec: v1beta2.KafkaSpec{
+			Kafka: v1beta2.KafkaClusterSpec{Version: "2.7.0", Replicas: 1},
+		},
+		Status: v1beta2.KafkaStatus{
+			ObservedGeneration: 10,
+			Conditions: []v1beta2.KafkaCondition{
+				{
+					Type:   v1beta2.KafkaConditionTypeReady,
+					Status: "True",
+				},
+			},
+		},
+	}
+
+	cli := test.NewFakeClientBuilder().AddK8sObjects(kafka).Build()
+	context := &operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	kafkaHandler := NewKafkaHandler(context)
+	key := types.NamespacedName{Name: "kafka", Namespace: ns}
+
+	version, err := kafkaHandler.GetKafkaClusterVersion(key)
+	assert.NoError(t, err)
+	assert.Equal(t, "2.7.0", version)
+
+	err = kafkaHandler.SetKafkaClusterVersion(key, "2.8.0")
+	assert.NoError(t, err)
+
+	version, err = kafkaHandler.GetKafkaClusterVersion(key)
+	assert.NoError(t, err)
+	assert.Equal(t, "2.8.0", version)
+
+	replicas, err := kafkaHandler.GetKafkaReplicaCount(key)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), replicas)
+
+	_, err = kafkaHandler.GetKafkaClusterVersion(types.NamespacedName{Name: "not-existing", Namespace: ns})
+	assert.Error(t, err)
+}
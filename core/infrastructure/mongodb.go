@@ -15,11 +15,16 @@
 package infrastructure
 
 import (
+	"fmt"
+	"net/url"
+
 	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
 	"github.com/kiegroup/kogito-operator/core/operator"
 	mongodb "github.com/mongodb/mongodb-kubernetes-operator/pkg/apis/mongodb/v1"
 	v1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 const (
@@ -42,6 +47,16 @@ const (
 	MongoDBAppSecretUsernameKey = "username"
 	// MongoDBAppSecretPasswordKey is the secret password key set in the linked secret for an application
 	MongoDBAppSecretPasswordKey = "password"
+
+	mongoDBURIScheme             = "mongodb"
+	mongoDBScramAuthMode         = "SCRAM"
+	mongoDBScramAuthMechanism    = "SCRAM-SHA-256"
+	mongoDBURIAuthSourceParam    = "authSource"
+	mongoDBURIAuthMechanismParam = "authMechanism"
+	mongoDBURISSLParam           = "ssl"
+
+	// mongoDBAdminSecretSuffix suffix of the secret holding the admin credentials of a MongoDB instance
+	mongoDBAdminSecretSuffix = "-admin"
 )
 
 var (
@@ -55,6 +70,8 @@ var (
 type MongoDBHandler interface {
 	IsMongoDBAvailable() bool
 	IsMongoDBOperatorAvailable(namespace string) (bool, error)
+	GetMongoDBConnectionString(namespace, instanceName, databaseName string) (string, error)
+	GetMongoDBAdminCredentials(namespace, instanceName string) (username, password string, err error)
 }
 
 type mongoDBHandler struct {
@@ -96,3 +113,72 @@ func (m *mongoDBHandler) IsMongoDBOperatorAvailable(namespace string) (bool, err
 	m.Log.Debug("Looks like MongoDB Operator is not available in the namespace", "namespace", namespace)
 	return false, nil
 }
+
+// GetMongoDBConnectionString returns the connection string to the given database of the MongoDB instance
+func (m *mongoDBHandler) GetMongoDBConnectionString(namespace, instanceName, databaseName string) (string, error) {
+	m.Log.Debug("Resolving MongoDB connection string", "instance", instanceName, "namespace", namespace)
+	mongoDBInstance := &mongodb.MongoDB{}
+	if exists, err := kubernetes.ResourceC(m.Client).FetchWithKey(types.NamespacedName{Name: instanceName, Namespace: namespace}, mongoDBInstance); err != nil {
+		return "", err
+	} else if !exists {
+		return "", fmt.Errorf("MongoDB instance %s not found in namespace %s", instanceName, namespace)
+	}
+	return getMongoDBConnectionString(mongoDBInstance, databaseName)
+}
+
+// GetMongoDBAdminCredentials returns the admin username and password stored in the <instanceName>-admin secret of the MongoDB instance
+func (m *mongoDBHandler) GetMongoDBAdminCredentials(namespace, instanceName string) (username, password string, err error) {
+	secretName := instanceName + mongoDBAdminSecretSuffix
+	m.Log.Debug("Reading MongoDB admin credentials", "secret", secretName, "namespace", namespace)
+	secret := &corev1.Secret{}
+	if exists, err := kubernetes.ResourceC(m.Client).FetchWithKey(types.NamespacedName{Name: secretName, Namespace: namespace}, secret); err != nil {
+		return "", "", err
+	} else if !exists {
+		return "", "", fmt.Errorf("MongoDB admin secret %s not found in namespace %s", secretName, namespace)
+	}
+
+	usernameValue, found := secret.Data[MongoDBAppSecretUsernameKey]
+	if !found || len(usernameValue) == 0 {
+		return "", "", fmt.Errorf("MongoDB admin secret %s doesn't contain key %s", secretName, MongoDBAppSecretUsernameKey)
+	}
+	passwordValue, found := secret.Data[MongoDBAppSecretPasswordKey]
+	if !found || len(passwordValue) == 0 {
+		return "", "", fmt.Errorf("MongoDB admin secret %s doesn't contain key %s", secretName, MongoDBAppSecretPasswordKey)
+	}
+	return string(usernameValue), string(passwordValue), nil
+}
+
+// getMongoDBConnectionString constructs the mongodb://<host>:<port>/<database> URI based on the MongoDB instance status
+func getMongoDBConnectionString(mongoDBInstance *mongodb.MongoDB, databaseName string) (string, error) {
+	mongoDBURI := mongoDBInstance.Status.MongoURI
+	if len(mongoDBURI) == 0 {
+		return "", fmt.Errorf("MongoDB instance %s doesn't have any URI in its status yet", mongoDBInstance.Name)
+	}
+	parsedURI, err := url.Parse(mongoDBURI)
+	if err != nil {
+		return "", fmt.Errorf("Error parsing URI %s of MongoDB instance %s: %v", mongoDBURI, mongoDBInstance.Name, err)
+	} else if len(parsedURI.Host) == 0 {
+		return "", fmt.Errorf("URI %s of MongoDB instance %s doesn't contain any host", mongoDBURI, mongoDBInstance.Name)
+	}
+
+	// Only the hosts of the status URI are kept, database and options are set from the instance configuration
+	connectionString := url.URL{
+		Scheme: mongoDBURIScheme,
+		Host:   parsedURI.Host,
+		Path:   "/" + databaseName,
+	}
+
+	params := url.Values{}
+	for _, mode := range mongoDBInstance.Spec.Security.Authentication.Modes {
+		if mode == mongoDBScramAuthMode {
+			params.Set(mongoDBURIAuthSourceParam, DefaultMongoDBAuthDatabase)
+			params.Set(mongoDBURIAuthMechanismParam, mongoDBScramAuthMechanism)
+		}
+	}
+	if mongoDBInstance.Spec.Security.TLS.Enabled {
+		params.Set(mongoDBURISSLParam, "true")
+	}
+	connectionString.RawQuery = params.Encode()
+
+	return connectionString.String(), nil
+}
@@ -0,0 +1,126 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"testing"
+
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"github.com/kiegroup/kogito-operator/core/test"
+	"github.com/kiegroup/kogito-operator/meta"
+	mongodb "github.com/mongodb/mongodb-kubernetes-operator/pkg/apis/mongodb/v1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_getMongoDBConnectionString(t *testing.T) {
+	tests := []struct {
+		name     string
+		security mongodb.Security
+		uri      string
+		want     string
+		wantErr  bool
+	}{
+		{
+			"NoAuthenticationNoTLS",
+			mongodb.Security{},
+			"mongodb://mongodb-0.mongodb-svc.test.svc.cluster.local:27017",
+			"mongodb://mongodb-0.mongodb-svc.test.svc.cluster.local:27017/kogito",
+			false,
+		},
+		{
+			"ScramAuthentication",
+			mongodb.Security{Authentication: mongodb.Authentication{Modes: []mongodb.AuthMode{"SCRAM"}}},
+			"mongodb://mongodb-0.mongodb-svc.test.svc.cluster.local:27017",
+			"mongodb://mongodb-0.mongodb-svc.test.svc.cluster.local:27017/kogito?authMechanism=SCRAM-SHA-256&authSource=admin",
+			false,
+		},
+		{
+			"TLSEnabled",
+			mongodb.Security{TLS: mongodb.TLS{Enabled: true}},
+			"mongodb://mongodb-0.mongodb-svc.test.svc.cluster.local:27017",
+			"mongodb://mongodb-0.mongodb-svc.test.svc.cluster.local:27017/kogito?ssl=true",
+			false,
+		},
+		{
+			"MultipleHosts",
+			mongodb.Security{},
+			"mongodb://mongodb-0.mongodb-svc:27017,mongodb-1.mongodb-svc:27017",
+			"mongodb://mongodb-0.mongodb-svc:27017,mongodb-1.mongodb-svc:27017/kogito",
+			false,
+		},
+		{
+			"NoURIInStatus",
+			mongodb.Security{},
+			"",
+			"",
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			instance := &mongodb.MongoDB{
+				ObjectMeta: v1.ObjectMeta{Name: "mongodb", Namespace: t.Name()},
+				Spec:       mongodb.MongoDBSpec{Security: tt.security},
+				Status:     mongodb.MongoDBStatus{MongoURI: tt.uri},
+			}
+			got, err := getMongoDBConnectionString(instance, "kogito")
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_getMongoDBAdminCredentials(t *testing.T) {
+	ns := t.Name()
+
+	adminSecret := &corev1.Secret{
+		ObjectMeta: v1.ObjectMeta{Name: "mongodb-admin", Namespace: ns},
+		Data: map[string][]byte{
+			"username": []byte("admin"),
+			"password": []byte("secret"),
+		},
+	}
+	incompleteSecret := &corev1.Secret{
+		ObjectMeta: v1.ObjectMeta{Name: "incomplete-admin", Namespace: ns},
+		Data: map[string][]byte{
+			"username": []byte("admin"),
+		},
+	}
+
+	cli := test.NewFakeClientBuilder().AddK8sObjects(adminSecret, incompleteSecret).Build()
+	context := &operator.Context{
+		Client: cli,
+		Log:    test.TestLogger,
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	mongoDBHandler := NewMongoDBHandler(context)
+
+	username, password, err := mongoDBHandler.GetMongoDBAdminCredentials(ns, "mongodb")
+	assert.NoError(t, err)
+	assert.Equal(t, "admin", username)
+	assert.Equal(t, "secret", password)
+
+	_, _, err = mongoDBHandler.GetMongoDBAdminCredentials(ns, "incomplete")
+	assert.Error(t, err)
+
+	_, _, err = mongoDBHandler.GetMongoDBAdminCredentials(ns, "missing")
+	assert.Error(t, err)
+}
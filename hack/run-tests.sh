@@ -53,6 +53,7 @@ function usage(){
   printf "\n--image_cache_mode\n\tUse this option to specify whether you want to use image cache for runtime images. Available options are 'always', 'never' or 'if-available'(default)."
   printf "\n--http_retry_nb {INT_VALUE}\n\tSet the retry number for all HTTP calls in case it fails (and response code != 500). Default value is 3."
   printf "\n--olm_namespace \n\tSet the namespace which is used for cluster scope operators. Default is 'openshift-operators'."
+  printf "\n--parallel\n\tIsolate the Kubernetes client per scenario, allowing scenarios to be executed in parallel. To be used together with '--concurrent'."
 
   # operator information
   printf "\n--operator_image {NAME}\n\tOperator image name. Default is 'quay.io/kiegroup/kogito-operator' one."
@@ -251,6 +252,10 @@ case $1 in
     shift
     if addParamKeyValueIfAccepted "--tests.olm-namespace" ${1}; then shift; fi
   ;;
+  --parallel)
+    addParam "--tests.parallel"
+    shift
+  ;;
 
   # operator information
   --operator_image)
@@ -7,9 +7,7 @@ Feature: Kogito integration with Keycloak
   Background:
     Given Namespace is created
     And Kogito Operator is deployed
-    And Infinispan Operator is deployed
-    And Kafka Operator is deployed
-    And Keycloak Operator is deployed
+    And Infinispan, Kafka and Keycloak Operators are deployed
 
   @dataindex
   Scenario: Install Kogito Data Index with Keycloak security
@@ -36,6 +36,7 @@ type TestConfig struct {
 	imageCacheMode   string
 	httpRetryNumber  int
 	olmNamespace     string
+	parallel         bool
 
 	// operator information
 	operatorImageName          string
@@ -135,6 +136,7 @@ func BindFlags(set *flag.FlagSet) {
 	set.StringVar(&env.imageCacheMode, prefix+"image-cache-mode", "if-available", "Use this option to specify whether you want to use image cache for runtime images. Available options are 'always', 'never' or 'if-available'(default).")
 	set.IntVar(&env.httpRetryNumber, prefix+"http-retry-nb", defaultHTTPRetryNumber, "Set the retry number for all HTTP calls in case it fails (and response code != 500). Default value is 3.")
 	set.StringVar(&env.olmNamespace, prefix+"olm-namespace", "openshift-operators", "Set the namespace which is used for cluster scope operators. Default is 'openshift-operators'.")
+	set.BoolVar(&env.parallel, prefix+"parallel", false, "Set to true to isolate the Kubernetes client per scenario, allowing scenarios to be executed in parallel.")
 
 	// operator information
 	set.StringVar(&env.operatorImageName, prefix+"operator-image-name", defaultOperatorImageName, "Operator image name")
@@ -251,6 +253,11 @@ func GetOlmNamespace() string {
 	return env.olmNamespace
 }
 
+// IsParallel returns whether each scenario should use its own Kubernetes client to allow parallel execution
+func IsParallel() bool {
+	return env.parallel
+}
+
 // operator information
 
 // GetOperatorImageName return the image name for the operator
@@ -30,6 +30,7 @@ import (
 	"github.com/kiegroup/kogito-operator/test/pkg/installers"
 	"github.com/kiegroup/kogito-operator/test/pkg/steps"
 
+	olmapiv1alpha1 "github.com/operator-framework/operator-lifecycle-manager/pkg/api/apis/operators/v1alpha1"
 	flag "github.com/spf13/pflag"
 )
 
@@ -164,10 +165,18 @@ func initializeTestSuite(ctx *godog.TestSuiteContext) {
 	// Final cleanup once test suite finishes
 	ctx.AfterSuite(func() {
 		if !config.IsKeepNamespace() {
+			// Retrieve CSVs before their subscriptions are deleted to verify their removal
+			csvs, err := framework.GetClusterWideTestCSVs()
+			if err != nil {
+				framework.GetMainLogger().Error(err, "Error retrieving cluster wide CSVs created by test suite")
+			}
+
 			// Delete all operators created by test suite
 			if success := installers.UninstallServicesFromCluster(); !success {
 				framework.GetMainLogger().Warn("Some services weren't uninstalled propertly from cluster, see error logs above")
 			}
+
+			verifyClusterWideCSVsDeleted(csvs)
 		}
 
 		if config.IsOperatorInstalledByOlm() {
@@ -175,9 +184,36 @@ func initializeTestSuite(ctx *godog.TestSuiteContext) {
 		}
 
 		stopOlmNamespaceMonitoring()
+		logMavenLocalRepositorySize()
 	})
 }
 
+func verifyClusterWideCSVsDeleted(csvs []olmapiv1alpha1.ClusterServiceVersion) {
+	remainingCsvs, err := framework.GetRemainingCSVs(csvs)
+	if err != nil {
+		framework.GetMainLogger().Error(err, "Error verifying deletion of cluster wide CSVs")
+	} else if len(remainingCsvs) > 0 {
+		framework.GetMainLogger().Warn("Some cluster wide CSVs weren't deleted", "CSVs", remainingCsvs)
+	}
+}
+
+func logMavenLocalRepositorySize() {
+	repoPath, err := framework.GetDefaultMavenLocalRepository()
+	if err != nil {
+		framework.GetMainLogger().Error(err, "Error resolving Maven local repository")
+		return
+	}
+	if _, err := os.Stat(repoPath); os.IsNotExist(err) {
+		return
+	}
+	size, err := framework.GetMavenLocalRepositorySize(repoPath)
+	if err != nil {
+		framework.GetMainLogger().Error(err, "Error computing Maven local repository size")
+		return
+	}
+	framework.GetMainLogger().Info("Maven local repository size", "path", repoPath, "size in MB", size/(1024*1024))
+}
+
 func initializeScenario(ctx *godog.ScenarioContext) {
 	// Register Steps
 	data := &steps.Data{}
@@ -192,6 +228,8 @@ func initializeScenario(ctx *godog.ScenarioContext) {
 	ctx.BeforeScenario(func(scenario *godog.Scenario) {
 		if err := data.BeforeScenario(scenario); err != nil {
 			framework.GetLogger(data.Namespace).Error(err, "Error in configuring data for before scenario")
+			// Scenario hooks cannot fail the scenario, stop here rather than running it with invalid data
+			panic(fmt.Errorf("Error in configuring data for before scenario: %v", err))
 		}
 	})
 	ctx.AfterScenario(func(scenario *godog.Scenario, err error) {
@@ -233,7 +271,8 @@ func deleteNamespaceIfExists(namespace string) {
 		if e := framework.DeleteNamespace(namespace); e != nil {
 			return fmt.Errorf("Error while deleting the namespace: %v", e)
 		}
-		return nil
+		// Next scenario could fail reusing the namespace while it is still terminating
+		return framework.WaitForNamespaceTerminated(namespace, framework.NamespaceDeletionTimeoutInMin)
 	})
 	if err != nil {
 		framework.GetLogger(namespace).Error(err, "Error while doing operator on namespace")
@@ -0,0 +1,122 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// InstallOperatorFromBundle creates all objects defined in the bundle manifest file, without OLM, namespaced objects are created in the given namespace if they don't define any
+func InstallOperatorFromBundle(namespace, bundleManifestPath string) error {
+	GetLogger(namespace).Info("Installing operator from bundle", "bundle", bundleManifestPath)
+	objects, err := readBundleObjects(namespace, bundleManifestPath)
+	if err != nil {
+		return err
+	}
+	for _, object := range objects {
+		if err := kubernetes.ResourceC(getKubeClient(namespace)).CreateIfNotExists(object); err != nil {
+			return fmt.Errorf("Error while creating %s %s from bundle %s: %v", object.GetKind(), object.GetName(), bundleManifestPath, err)
+		}
+	}
+	return nil
+}
+
+// UninstallOperatorFromBundle deletes all objects defined in the bundle manifest file, in reverse order of their definition
+func UninstallOperatorFromBundle(namespace, bundleManifestPath string) error {
+	GetLogger(namespace).Info("Uninstalling operator from bundle", "bundle", bundleManifestPath)
+	objects, err := readBundleObjects(namespace, bundleManifestPath)
+	if err != nil {
+		return err
+	}
+	for i := len(objects) - 1; i >= 0; i-- {
+		if err := kubernetes.ResourceC(getKubeClient(namespace)).Delete(objects[i]); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("Error while deleting %s %s from bundle %s: %v", objects[i].GetKind(), objects[i].GetName(), bundleManifestPath, err)
+		}
+	}
+	return nil
+}
+
+// readBundleObjects reads objects from the bundle manifest file and sets the namespace of namespaced objects not defining it
+func readBundleObjects(namespace, bundleManifestPath string) ([]*unstructured.Unstructured, error) {
+	content, err := ioutil.ReadFile(bundleManifestPath)
+	if err != nil {
+		return nil, fmt.Errorf("Error while reading bundle %s: %v", bundleManifestPath, err)
+	}
+	objects, err := parseBundleManifest(string(content))
+	if err != nil {
+		return nil, fmt.Errorf("Error while parsing bundle %s: %v", bundleManifestPath, err)
+	}
+
+	for _, object := range objects {
+		if len(object.GetNamespace()) > 0 {
+			continue
+		}
+		namespaced, err := isNamespacedKind(object.GroupVersionKind())
+		if err != nil {
+			return nil, err
+		} else if namespaced {
+			object.SetNamespace(namespace)
+		}
+	}
+	return objects, nil
+}
+
+// parseBundleManifest parses all objects of the multi-document YAML content, empty documents are skipped
+func parseBundleManifest(content string) ([]*unstructured.Unstructured, error) {
+	var objects []*unstructured.Unstructured
+	decoder := yaml.NewYAMLOrJSONDecoder(strings.NewReader(content), len(content))
+	for {
+		object := &unstructured.Unstructured{}
+		if err := decoder.Decode(&object.Object); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		if len(object.Object) == 0 {
+			continue
+		}
+		if len(object.GetKind()) == 0 || len(object.GetName()) == 0 {
+			return nil, fmt.Errorf("object without kind or name found: %v", object.Object)
+		}
+		objects = append(objects, object)
+	}
+	return objects, nil
+}
+
+// isNamespacedKind checks using discovery API whether the kind is namespaced
+func isNamespacedKind(gvk schema.GroupVersionKind) (bool, error) {
+	if kubeClient.Discovery == nil {
+		return false, fmt.Errorf("Discovery API isn't available to resolve scope of %s", gvk.Kind)
+	}
+	resources, err := kubeClient.Discovery.ServerResourcesForGroupVersion(gvk.GroupVersion().String())
+	if err != nil {
+		return false, fmt.Errorf("Error while retrieving resources of API group version %s: %v", gvk.GroupVersion().String(), err)
+	}
+	for _, resource := range resources.APIResources {
+		if resource.Kind == gvk.Kind && !strings.Contains(resource.Name, "/") {
+			return resource.Namespaced, nil
+		}
+	}
+	return false, fmt.Errorf("Kind %s not found in API group version %s", gvk.Kind, gvk.GroupVersion().String())
+}
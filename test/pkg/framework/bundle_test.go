@@ -0,0 +1,57 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseBundleManifest(t *testing.T) {
+	content := `
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: kogito-operator
+---
+# comment only document
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: kogito-operator-controller-manager
+  namespace: kogito
+`
+	objects, err := parseBundleManifest(content)
+	assert.NoError(t, err)
+	assert.Len(t, objects, 2)
+	assert.Equal(t, "ServiceAccount", objects[0].GetKind())
+	assert.Equal(t, "kogito-operator", objects[0].GetName())
+	assert.Empty(t, objects[0].GetNamespace())
+	assert.Equal(t, "apps", objects[1].GroupVersionKind().Group)
+	assert.Equal(t, "Deployment", objects[1].GetKind())
+	assert.Equal(t, "kogito", objects[1].GetNamespace())
+}
+
+func TestParseBundleManifestWithoutKind(t *testing.T) {
+	content := `
+apiVersion: v1
+metadata:
+  name: kogito-operator
+`
+	_, err := parseBundleManifest(content)
+	assert.Error(t, err)
+}
@@ -17,14 +17,20 @@ package framework
 import (
 	"fmt"
 
+	"github.com/kiegroup/kogito-operator/api/v1beta1"
+	"github.com/kiegroup/kogito-operator/meta"
 	"github.com/kiegroup/kogito-operator/test/pkg/config"
 )
 
 var verifications = []func() error{
 	checkKubernetesAndDomainSuffix,
 	checkImageCacheMode,
+	checkKogitoKindsRegistered,
 }
 
+// kogitoKinds Kogito custom resource kinds used by BDD tests
+var kogitoKinds = []string{"KogitoRuntime", "KogitoBuild", "KogitoInfra", "KogitoSupportingService"}
+
 // CheckSetup verifies the configuration is correct
 func CheckSetup() error {
 	for _, verification := range verifications {
@@ -51,3 +57,22 @@ func checkImageCacheMode() error {
 	}
 	return (fmt.Errorf("Invalid image cache mode: %s", imageCacheMode))
 }
+
+func checkKogitoKindsRegistered() error {
+	for _, kind := range kogitoKinds {
+		if !IsCRDRegisteredInScheme(kind, v1beta1.GroupVersion.Group) {
+			return fmt.Errorf("Kind %s of group %s is missing registration in the scheme returned by meta.GetRegisteredSchema()", kind, v1beta1.GroupVersion.Group)
+		}
+	}
+	return nil
+}
+
+// IsCRDRegisteredInScheme returns true if the kind of the group is registered in the scheme used by the framework, in any version
+func IsCRDRegisteredInScheme(kind, group string) bool {
+	for gvk := range meta.GetRegisteredSchema().AllKnownTypes() {
+		if gvk.Kind == kind && gvk.Group == group {
+			return true
+		}
+	}
+	return false
+}
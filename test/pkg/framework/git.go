@@ -0,0 +1,69 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	git "gopkg.in/src-d/go-git.v4"
+	"gopkg.in/src-d/go-git.v4/plumbing"
+)
+
+// CloneConfig contains configuration of a Git repository clone
+type CloneConfig struct {
+	URL string
+	// Depth limits fetching to the given number of commits, full clone is done when depth <= 0
+	Depth int
+}
+
+// NewCloneConfig creates the configuration to fully clone the Git repository
+func NewCloneConfig(url string) *CloneConfig {
+	return &CloneConfig{URL: url}
+}
+
+// WithShallowClone limits the clone to the given number of commits
+func (cfg *CloneConfig) WithShallowClone(depth int) *CloneConfig {
+	cfg.Depth = depth
+	return cfg
+}
+
+// CloneRepository clones the Git repository into the location, reference is resolved as branch first and as tag if no branch matches
+func CloneRepository(location, reference string, cfg *CloneConfig) error {
+	cloneOptions := &git.CloneOptions{
+		URL:          cfg.URL,
+		SingleBranch: true,
+	}
+	if cfg.Depth > 0 {
+		cloneOptions.Depth = cfg.Depth
+	}
+
+	if len(reference) == 0 {
+		return cloneRepository(location, cloneOptions)
+	}
+
+	// Try cloning as branch reference
+	cloneOptions.ReferenceName = plumbing.NewBranchReferenceName(reference)
+	// If branch clone was successful then return, otherwise try other cloning options
+	if err := cloneRepository(location, cloneOptions); err == nil {
+		return nil
+	}
+
+	// If branch cloning failed then try cloning as tag
+	cloneOptions.ReferenceName = plumbing.NewTagReferenceName(reference)
+	return cloneRepository(location, cloneOptions)
+}
+
+func cloneRepository(location string, cloneOptions *git.CloneOptions) error {
+	_, err := git.PlainClone(location, false, cloneOptions)
+	return err
+}
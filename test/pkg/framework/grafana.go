@@ -51,7 +51,7 @@ func DeployGrafanaInstance(namespace, labelName, labelValue string) error {
 			Compat: &grafanav1.GrafanaCompat{},
 		},
 	}
-	if err := kubernetes.ResourceC(kubeClient).Create(grafanaCR); err != nil {
+	if err := kubernetes.ResourceC(getKubeClient(namespace)).Create(grafanaCR); err != nil {
 		return fmt.Errorf("Error while creating Grafana CR: %v ", err)
 	}
 
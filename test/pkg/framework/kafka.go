@@ -15,48 +15,166 @@
 package framework
 
 import (
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
+	"strings"
+	"time"
+
 	"github.com/kiegroup/kogito-operator/core/infrastructure/kafka/v1beta2"
 
 	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+	"github.com/kiegroup/kogito-operator/core/infrastructure"
+	"github.com/kiegroup/kogito-operator/core/logger"
+	"github.com/kiegroup/kogito-operator/core/operator"
+	"github.com/kiegroup/kogito-operator/meta"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	kafkaPlainListenerType = "plain"
+	kafkaClusterLabel      = "strimzi.io/cluster"
+
+	// kafkaNodePortListenerType and kafkaLoadBalancerListenerType types of the listeners exposed outside of the cluster
+	kafkaNodePortListenerType     = "nodeport"
+	kafkaLoadBalancerListenerType = "loadbalancer"
+
+	// kafkaClusterCACertSecretSuffix suffix of the secret created by Strimzi containing the cluster CA certificate signing listener certificates
+	kafkaClusterCACertSecretSuffix = "-cluster-ca-cert"
+	kafkaClusterCACertKey          = "ca.crt"
+	// kafkaCertificateMinValidity minimal remaining validity of listener certificates
+	kafkaCertificateMinValidity = 30 * 24 * time.Hour
 )
 
 // DeployKafkaInstance deploys an instance of Kafka
 func DeployKafkaInstance(namespace string, kafka *v1beta2.Kafka) error {
 	GetLogger(namespace).Info("Creating Kafka instance %s.", "name", kafka.Name)
 
-	if err := kubernetes.ResourceC(kubeClient).Create(kafka); err != nil {
+	if err := EnsureKafkaListenerPortAvailable(namespace, getKafkaExternalListenerPorts(kafka)); err != nil {
+		return err
+	}
+
+	if err := kubernetes.ResourceC(getKubeClient(namespace)).Create(kafka); err != nil {
 		return fmt.Errorf("Error while creating Kafka: %v ", err)
 	}
 
 	return nil
 }
 
+// EnsureKafkaListenerPortAvailable checks that none of the given external Kafka listener ports is already exposed outside of the cluster by a Service in the namespace.
+// Only node ports and load balancer ports can clash, ClusterIP services get their own IP. Strimzi doesn't report such conflicts.
+func EnsureKafkaListenerPortAvailable(namespace string, ports []int32) error {
+	services := &corev1.ServiceList{}
+	if err := kubernetes.ResourceC(getKubeClient(namespace)).ListWithNamespace(namespace, services); err != nil {
+		return fmt.Errorf("Error while listing services in namespace %s: %v", namespace, err)
+	}
+
+	var conflicts []string
+	for _, service := range services.Items {
+		if service.Spec.Type != corev1.ServiceTypeNodePort && service.Spec.Type != corev1.ServiceTypeLoadBalancer {
+			continue
+		}
+		for _, servicePort := range service.Spec.Ports {
+			for _, port := range ports {
+				if servicePort.NodePort == port || (service.Spec.Type == corev1.ServiceTypeLoadBalancer && servicePort.Port == port) {
+					conflicts = append(conflicts, fmt.Sprintf("%d (service %s)", port, service.Name))
+				}
+			}
+		}
+	}
+	if len(conflicts) > 0 {
+		return fmt.Errorf("Kafka listener ports already exposed in namespace %s: %s", namespace, strings.Join(conflicts, ", "))
+	}
+	return nil
+}
+
+// getKafkaExternalListenerPorts returns the ports of the Kafka listeners exposed outside of the cluster
+func getKafkaExternalListenerPorts(kafka *v1beta2.Kafka) []int32 {
+	var ports []int32
+	for _, listener := range kafka.Spec.Kafka.Listeners {
+		if listener.ListenerType == kafkaNodePortListenerType || listener.ListenerType == kafkaLoadBalancerListenerType {
+			ports = append(ports, int32(listener.Port))
+		}
+	}
+	return ports
+}
+
 // DeployKafkaTopic deploys a Kafka topic
 func DeployKafkaTopic(namespace, kafkaTopicName, kafkaInstanceName string) error {
-	GetLogger(namespace).Info("Creating Kafka", "topic", kafkaTopicName, "instanceName", kafkaInstanceName)
+	return DeployKafkaTopicWithConfig(namespace, kafkaTopicName, kafkaInstanceName, 1, 1, nil)
+}
+
+// DeployKafkaTopicWithConfig deploys a Kafka topic with given partitions, replicas and topic configuration
+func DeployKafkaTopicWithConfig(namespace, kafkaTopicName, kafkaInstanceName string, partitions, replicas int32, topicConfig map[string]string) error {
+	GetLogger(namespace).Info("Creating Kafka", "topic", kafkaTopicName, "instanceName", kafkaInstanceName, "partitions", partitions, "replicas", replicas)
 
 	kafkaTopic := &v1beta2.KafkaTopic{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: namespace,
 			Name:      kafkaTopicName,
-			Labels:    map[string]string{"strimzi.io/cluster": kafkaInstanceName},
+			Labels:    map[string]string{kafkaClusterLabel: kafkaInstanceName},
 		},
 		Spec: v1beta2.KafkaTopicSpec{
-			Replicas:   1,
-			Partitions: 1,
+			Replicas:   replicas,
+			Partitions: partitions,
+			Config:     topicConfig,
 		},
 	}
 
-	if err := kubernetes.ResourceC(kubeClient).Create(kafkaTopic); err != nil {
+	if err := kubernetes.ResourceC(getKubeClient(namespace)).Create(kafkaTopic); err != nil {
 		return fmt.Errorf("Error while creating Kafka Topic: %v ", err)
 	}
 
 	return nil
 }
 
+// WaitForKafkaTopicCreated waits for the Kafka topic of the Kafka instance to be created
+func WaitForKafkaTopicCreated(namespace, kafkaInstanceName, topicName string, timeoutInMin int) error {
+	return WaitForOnOpenshift(namespace, fmt.Sprintf("Kafka topic %s created", topicName), timeoutInMin,
+		func() (bool, error) {
+			return kafkaTopicExists(namespace, kafkaInstanceName, topicName)
+		})
+}
+
+// WaitForKafkaTopicDeleted waits for the Kafka topic to be deleted
+func WaitForKafkaTopicDeleted(namespace, topicName string, timeoutInMin int) error {
+	GetLogger(namespace).Info("Waiting for Kafka topic to be deleted", "topic", topicName, "timeoutInMin", timeoutInMin)
+	context := &operator.Context{
+		Client: getKubeClient(namespace),
+		Log:    logger.GetLogger(namespace),
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	return infrastructure.NewKafkaHandler(context).WaitForKafkaTopicDeleted(namespace, topicName, timeoutInMin)
+}
+
+// WaitForKafkaReady waits for the Kafka instance to be ready and reachable through its bootstrap service
+func WaitForKafkaReady(namespace, instanceName string, timeoutInMin int) error {
+	GetLogger(namespace).Info("Waiting for Kafka instance to be ready", "instance name", instanceName, "timeoutInMin", timeoutInMin)
+	context := &operator.Context{
+		Client: getKubeClient(namespace),
+		Log:    logger.GetLogger(namespace),
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	return infrastructure.NewKafkaHandler(context).WaitForKafkaReady(namespace, instanceName, timeoutInMin)
+}
+
+// kafkaTopicExists checks whether a Kafka topic labeled with the Kafka instance exists, topics created by Strimzi can have a different resource name than topic name
+func kafkaTopicExists(namespace, kafkaInstanceName, topicName string) (bool, error) {
+	kafkaTopics := &v1beta2.KafkaTopicList{}
+	if err := kubernetes.ResourceC(getKubeClient(namespace)).ListWithNamespaceAndLabel(namespace, kafkaTopics, map[string]string{kafkaClusterLabel: kafkaInstanceName}); err != nil {
+		return false, fmt.Errorf("Error while listing Kafka topics: %v ", err)
+	}
+	for _, kafkaTopic := range kafkaTopics.Items {
+		if kafkaTopic.Name == topicName || kafkaTopic.Spec.TopicName == topicName {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 // ScaleKafkaInstanceDown scales a Kafka instance down by killing its pod temporarily
 func ScaleKafkaInstanceDown(namespace, kafkaInstanceName string) error {
 	GetLogger(namespace).Info("Scaling Kafka Instance down", "instance name", kafkaInstanceName)
@@ -72,3 +190,171 @@ func ScaleKafkaInstanceDown(namespace, kafkaInstanceName string) error {
 
 	return nil
 }
+
+// GetKafkaReplicaCount returns the number of configured replicas of the Kafka instance
+func GetKafkaReplicaCount(namespace, instanceName string) (int32, error) {
+	context := &operator.Context{
+		Client: getKubeClient(namespace),
+		Log:    logger.GetLogger(namespace),
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	return infrastructure.NewKafkaHandler(context).GetKafkaReplicaCount(types.NamespacedName{Name: instanceName, Namespace: namespace})
+}
+
+// GetKafkaZookeeperReplicas returns the number of configured ZooKeeper replicas of the Kafka instance
+func GetKafkaZookeeperReplicas(namespace, instanceName string) (int32, error) {
+	context := &operator.Context{
+		Client: getKubeClient(namespace),
+		Log:    logger.GetLogger(namespace),
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	return infrastructure.NewKafkaHandler(context).GetKafkaZookeeperReplicas(types.NamespacedName{Name: instanceName, Namespace: namespace})
+}
+
+// GetKafkaListenerCount returns the number of listeners configured for the Kafka instance
+func GetKafkaListenerCount(namespace, instanceName string) (int, error) {
+	context := &operator.Context{
+		Client: getKubeClient(namespace),
+		Log:    logger.GetLogger(namespace),
+		Scheme: meta.GetRegisteredSchema(),
+	}
+	return infrastructure.NewKafkaHandler(context).GetKafkaListenerCount(types.NamespacedName{Name: instanceName, Namespace: namespace})
+}
+
+// GetKafkaInstance retrieves the Kafka instance with given name in namespace
+func GetKafkaInstance(namespace, instanceName string) (*v1beta2.Kafka, error) {
+	kafka := &v1beta2.Kafka{}
+	if exists, err := kubernetes.ResourceC(getKubeClient(namespace)).FetchWithKey(types.NamespacedName{Name: instanceName, Namespace: namespace}, kafka); err != nil {
+		return nil, fmt.Errorf("Error while trying to look for Kafka instance %s: %v ", instanceName, err)
+	} else if !exists {
+		return nil, fmt.Errorf("Kafka instance %s doesn't exist in namespace %s", instanceName, namespace)
+	}
+	return kafka, nil
+}
+
+// VerifyKafkaListenerPort checks that the Kafka instance exposes a plain listener on the expected port
+func VerifyKafkaListenerPort(namespace, instanceName string, expectedPort int32) error {
+	kafka, err := GetKafkaInstance(namespace, instanceName)
+	if err != nil {
+		return err
+	}
+
+	var foundPorts []int32
+	for _, listener := range kafka.Status.Listeners {
+		if listener.Type == kafkaPlainListenerType {
+			for _, address := range listener.Addresses {
+				if address.Port == expectedPort {
+					return nil
+				}
+				foundPorts = append(foundPorts, address.Port)
+			}
+		}
+	}
+
+	return fmt.Errorf("Kafka instance %s doesn't have a plain listener on port %d, found ports: %v", instanceName, expectedPort, foundPorts)
+}
+
+// VerifyKafkaListenerTLSCertificate checks that the certificates of the Kafka listener are valid for at least 30 more days
+func VerifyKafkaListenerTLSCertificate(namespace, kafkaInstanceName, listenerName string) error {
+	kafka, err := GetKafkaInstance(namespace, kafkaInstanceName)
+	if err != nil {
+		return err
+	}
+
+	var listenerStatus *v1beta2.ListenerStatus
+	for i, listener := range kafka.Status.Listeners {
+		// Older Strimzi versions identify listeners by type only
+		if listener.Name == listenerName || (len(listener.Name) == 0 && listener.Type == listenerName) {
+			listenerStatus = &kafka.Status.Listeners[i]
+			break
+		}
+	}
+	if listenerStatus == nil {
+		return fmt.Errorf("Kafka instance %s doesn't have any listener %s", kafkaInstanceName, listenerName)
+	}
+
+	certificates := listenerStatus.Certificates
+	if len(certificates) == 0 {
+		// Certificates not reported in status, listener certificates are signed by the cluster CA
+		secretName := kafkaInstanceName + kafkaClusterCACertSecretSuffix
+		secret := &corev1.Secret{}
+		if exists, err := kubernetes.ResourceC(getKubeClient(namespace)).FetchWithKey(types.NamespacedName{Name: secretName, Namespace: namespace}, secret); err != nil {
+			return fmt.Errorf("Error while trying to fetch certificate secret %s: %v", secretName, err)
+		} else if !exists {
+			return fmt.Errorf("Certificate secret %s of Kafka listener %s not found in namespace %s", secretName, listenerName, namespace)
+		}
+		certificate, found := secret.Data[kafkaClusterCACertKey]
+		if !found {
+			return fmt.Errorf("Certificate secret %s doesn't contain key %s", secretName, kafkaClusterCACertKey)
+		}
+		certificates = []string{string(certificate)}
+	}
+
+	for _, certificate := range certificates {
+		if err := verifyPEMCertificateValidity(certificate, kafkaCertificateMinValidity); err != nil {
+			return fmt.Errorf("Invalid certificate of Kafka listener %s: %v", listenerName, err)
+		}
+	}
+	return nil
+}
+
+// CorrelateKafkaListenerWithService checks that the Kafka listener is exposed by its service, named <instance>-kafka-<listener>, on the listener port
+func CorrelateKafkaListenerWithService(namespace, kafkaInstanceName, listenerName string) error {
+	kafka, err := GetKafkaInstance(namespace, kafkaInstanceName)
+	if err != nil {
+		return err
+	}
+
+	var listener *v1beta2.GenericKafkaListener
+	for i := range kafka.Spec.Kafka.Listeners {
+		if kafka.Spec.Kafka.Listeners[i].Name == listenerName {
+			listener = &kafka.Spec.Kafka.Listeners[i]
+			break
+		}
+	}
+	if listener == nil {
+		return fmt.Errorf("Kafka instance %s doesn't define any listener %s", kafkaInstanceName, listenerName)
+	}
+
+	serviceName := fmt.Sprintf("%s-kafka-%s", kafkaInstanceName, listenerName)
+	service := &corev1.Service{}
+	if exists, err := kubernetes.ResourceC(getKubeClient(namespace)).FetchWithKey(types.NamespacedName{Name: serviceName, Namespace: namespace}, service); err != nil {
+		return fmt.Errorf("Error while trying to fetch service %s of Kafka listener %s: %v", serviceName, listenerName, err)
+	} else if !exists {
+		return fmt.Errorf("Service %s of Kafka listener %s not found in namespace %s", serviceName, listenerName, namespace)
+	}
+
+	var servicePorts []int32
+	for _, port := range service.Spec.Ports {
+		if port.Port == int32(listener.Port) {
+			return nil
+		}
+		servicePorts = append(servicePorts, port.Port)
+	}
+	return fmt.Errorf("Service %s doesn't expose port %d of Kafka listener %s, found ports: %v", serviceName, listener.Port, listenerName, servicePorts)
+}
+
+// verifyPEMCertificateValidity checks that all certificates in the PEM content are valid for at least the given duration
+func verifyPEMCertificateValidity(pemContent string, minValidity time.Duration) error {
+	rest := []byte(pemContent)
+	parsedCertificates := 0
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		certificate, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return fmt.Errorf("Error while parsing certificate: %v", err)
+		}
+		if time.Now().Add(minValidity).After(certificate.NotAfter) {
+			return fmt.Errorf("certificate %s expires on %s, less than %s from now", certificate.Subject.CommonName, certificate.NotAfter.Format(time.RFC3339), minValidity)
+		}
+		parsedCertificates++
+	}
+	if parsedCertificates == 0 {
+		return fmt.Errorf("no PEM certificate found")
+	}
+	return nil
+}
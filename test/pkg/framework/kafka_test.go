@@ -0,0 +1,68 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"testing"
+
+	"github.com/kiegroup/kogito-operator/core/infrastructure/kafka/v1beta2"
+	"github.com/kiegroup/kogito-operator/core/test"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestEnsureKafkaListenerPortAvailable(t *testing.T) {
+	ns := t.Name()
+	kafka := &v1beta2.Kafka{
+		ObjectMeta: metav1.ObjectMeta{Name: "kafka", Namespace: ns},
+		Spec: v1beta2.KafkaSpec{
+			Kafka: v1beta2.KafkaClusterSpec{
+				Listeners: []v1beta2.GenericKafkaListener{
+					{Name: "plain", Port: 9092, ListenerType: "internal"},
+					{Name: "external", Port: 30092, ListenerType: kafkaNodePortListenerType},
+				},
+			},
+		},
+	}
+	nodePortService := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "external-service", Namespace: ns},
+		Spec: corev1.ServiceSpec{
+			Type:  corev1.ServiceTypeNodePort,
+			Ports: []corev1.ServicePort{{Port: 8080, NodePort: 30092}},
+		},
+	}
+	clusterIPService := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "internal-service", Namespace: ns},
+		Spec: corev1.ServiceSpec{
+			Type:  corev1.ServiceTypeClusterIP,
+			Ports: []corev1.ServicePort{{Port: 9092}},
+		},
+	}
+
+	ports := getKafkaExternalListenerPorts(kafka)
+	assert.Equal(t, []int32{30092}, ports)
+
+	previousClient := kubeClient
+	defer func() { kubeClient = previousClient }()
+
+	kubeClient = test.NewFakeClientBuilder().AddK8sObjects(clusterIPService).Build()
+	assert.NoError(t, EnsureKafkaListenerPortAvailable(ns, ports))
+
+	kubeClient = test.NewFakeClientBuilder().AddK8sObjects(nodePortService, clusterIPService).Build()
+	err := EnsureKafkaListenerPortAvailable(ns, ports)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "external-service")
+}
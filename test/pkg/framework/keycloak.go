@@ -22,6 +22,7 @@ import (
 	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
 	framework1 "github.com/kiegroup/kogito-operator/core/framework"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 
 	keycloak "github.com/keycloak/keycloak-operator/pkg/apis/keycloak/v1alpha1"
 )
@@ -44,7 +45,7 @@ func DeployKeycloakInstance(namespace string) error {
 		},
 	}
 
-	if err := kubernetes.ResourceC(kubeClient).Create(keycloak); err != nil {
+	if err := kubernetes.ResourceC(getKubeClient(namespace)).Create(keycloak); err != nil {
 		return fmt.Errorf("Error while creating Keycloak: %v ", err)
 	}
 
@@ -54,22 +55,30 @@ func DeployKeycloakInstance(namespace string) error {
 // DeployKeycloakRealm deploys a realm configuration of Keycloak
 func DeployKeycloakRealm(namespace, realmName string) error {
 	GetLogger(namespace).Info("Creating Keycloak realm", "realmName", realmName)
+	return kubernetes.ResourceC(getKubeClient(namespace)).Create(createKeycloakRealm(namespace, realmName))
+}
 
-	realm := &keycloak.KeycloakRealm{
-		ObjectMeta: createKeycloakMeta(namespace, realmName),
-		Spec: keycloak.KeycloakRealmSpec{
-			InstanceSelector: &metav1.LabelSelector{
-				MatchLabels: createKeycloakLabel(namespace),
-			},
-			Realm: &keycloak.KeycloakAPIRealm{
-				ID:      realmName,
-				Realm:   realmName,
-				Enabled: true,
-			},
-		},
+// CreateKeycloakRealmIfNotExists deploys a realm configuration of Keycloak if a realm with the same name doesn't exist yet
+func CreateKeycloakRealmIfNotExists(namespace, realmName string) error {
+	GetLogger(namespace).Info("Creating Keycloak realm if not exists", "realmName", realmName)
+	if err := kubernetes.ResourceC(getKubeClient(namespace)).CreateIfNotExists(createKeycloakRealm(namespace, realmName)); err != nil {
+		return fmt.Errorf("Error while creating Keycloak realm %s: %v ", realmName, err)
 	}
+	return nil
+}
 
-	return kubernetes.ResourceC(kubeClient).Create(realm)
+// WaitForKeycloakRealmReady waits for the Keycloak realm to be reported as ready by the Keycloak operator
+func WaitForKeycloakRealmReady(namespace, realmName string, timeoutInMin int) error {
+	return WaitForOnOpenshift(namespace, fmt.Sprintf("Keycloak realm %s to be ready", realmName), timeoutInMin,
+		func() (bool, error) {
+			realm := &keycloak.KeycloakRealm{}
+			if exists, err := kubernetes.ResourceC(getKubeClient(namespace)).FetchWithKey(types.NamespacedName{Name: realmName, Namespace: namespace}, realm); err != nil {
+				return false, fmt.Errorf("Error while fetching Keycloak realm %s: %v ", realmName, err)
+			} else if !exists {
+				return false, nil
+			}
+			return realm.Status.Ready, nil
+		})
 }
 
 // DeployKeycloakClient deploys a client configuration of Keycloak
@@ -98,7 +107,7 @@ func DeployKeycloakClient(namespace, clientName string) error {
 		},
 	}
 
-	return kubernetes.ResourceC(kubeClient).Create(client)
+	return kubernetes.ResourceC(getKubeClient(namespace)).Create(client)
 }
 
 // DeployKeycloakUser deploys a realm configuration of Keycloak
@@ -127,7 +136,7 @@ func DeployKeycloakUser(namespace, userName, password string) error {
 		},
 	}
 
-	return kubernetes.ResourceC(kubeClient).Create(user)
+	return kubernetes.ResourceC(getKubeClient(namespace)).Create(user)
 }
 
 // GetAccessTokenFromKeycloak gets the access token for a user
@@ -162,6 +171,22 @@ func RetrieveKeycloakEndpointURI(namespace string) (string, error) {
 	return regexp.MustCompile(":[0-9]+").ReplaceAllString(uri, ""), nil
 }
 
+func createKeycloakRealm(namespace, realmName string) *keycloak.KeycloakRealm {
+	return &keycloak.KeycloakRealm{
+		ObjectMeta: createKeycloakMeta(namespace, realmName),
+		Spec: keycloak.KeycloakRealmSpec{
+			InstanceSelector: &metav1.LabelSelector{
+				MatchLabels: createKeycloakLabel(namespace),
+			},
+			Realm: &keycloak.KeycloakAPIRealm{
+				ID:      realmName,
+				Realm:   realmName,
+				Enabled: true,
+			},
+		},
+	}
+}
+
 func createKeycloakMeta(namespace, name string) metav1.ObjectMeta {
 	return metav1.ObjectMeta{
 		Namespace: namespace,
@@ -28,6 +28,7 @@ import (
 	bddtypes "github.com/kiegroup/kogito-operator/test/pkg/types"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 )
@@ -47,10 +48,10 @@ func DeployKogitoBuild(namespace string, installerType InstallerType, buildHolde
 }
 
 func crDeployKogitoBuild(buildHolder *bddtypes.KogitoBuildHolder) error {
-	if err := kubernetes.ResourceC(kubeClient).CreateIfNotExists(buildHolder.KogitoBuild); err != nil {
+	if err := kubernetes.ResourceC(getKubeClient(buildHolder.KogitoBuild.GetNamespace())).CreateIfNotExists(buildHolder.KogitoBuild); err != nil {
 		return fmt.Errorf("Error creating example build %s: %v", buildHolder.KogitoBuild.GetName(), err)
 	}
-	if err := kubernetes.ResourceC(kubeClient).CreateIfNotExists(buildHolder.KogitoService); err != nil {
+	if err := kubernetes.ResourceC(getKubeClient(buildHolder.KogitoBuild.GetNamespace())).CreateIfNotExists(buildHolder.KogitoService); err != nil {
 		return fmt.Errorf("Error creating example service %s: %v", buildHolder.KogitoService.GetName(), err)
 	}
 	return nil
@@ -100,7 +101,7 @@ func GetKogitoBuildStub(namespace, runtimeType, name string) *v1beta1.KogitoBuil
 // GetKogitoBuild returns the KogitoBuild type
 func GetKogitoBuild(namespace, buildName string) (*v1beta1.KogitoBuild, error) {
 	build := &v1beta1.KogitoBuild{}
-	if exists, err := kubernetes.ResourceC(kubeClient).FetchWithKey(types.NamespacedName{Name: buildName, Namespace: namespace}, build); err != nil && !errors.IsNotFound(err) {
+	if exists, err := kubernetes.ResourceC(getKubeClient(namespace)).FetchWithKey(types.NamespacedName{Name: buildName, Namespace: namespace}, build); err != nil && !errors.IsNotFound(err) {
 		return nil, fmt.Errorf("Error while trying to look for KogitoBuild %s: %v ", buildName, err)
 	} else if errors.IsNotFound(err) || !exists {
 		return nil, nil
@@ -108,6 +109,67 @@ func GetKogitoBuild(namespace, buildName string) (*v1beta1.KogitoBuild, error) {
 	return build, nil
 }
 
+// WaitForKogitoBuildComplete waits for the KogitoBuild to be reported as successfully completed, failing fast if the build failed
+func WaitForKogitoBuildComplete(namespace, buildName string, timeoutInMin int) error {
+	return WaitForOnOpenshift(namespace, fmt.Sprintf("KogitoBuild %s complete", buildName), timeoutInMin,
+		func() (bool, error) {
+			build, err := GetKogitoBuild(namespace, buildName)
+			if err != nil || build == nil || build.Status.Conditions == nil {
+				return false, err
+			}
+			successfulCondition := apimeta.FindStatusCondition(*build.Status.Conditions, string(api.KogitoBuildSuccessful))
+			return successfulCondition != nil &&
+				successfulCondition.Status == metav1.ConditionTrue &&
+				successfulCondition.Reason == string(api.BuildPhaseCompleteReason), nil
+		},
+		func() (bool, error) {
+			build, err := GetKogitoBuild(namespace, buildName)
+			if err != nil || build == nil || build.Status.Conditions == nil {
+				return false, err
+			}
+			if failedCondition := apimeta.FindStatusCondition(*build.Status.Conditions, string(api.KogitoBuildFailure)); failedCondition != nil && failedCondition.Status == metav1.ConditionTrue {
+				return true, fmt.Errorf("KogitoBuild %s failed with reason %s: %s", buildName, failedCondition.Reason, failedCondition.Message)
+			}
+			return false, nil
+		})
+}
+
+// GetKogitoBuildFailureReason returns the reason and message of the Failed condition of the KogitoBuild, empty if the build didn't fail
+func GetKogitoBuildFailureReason(namespace, buildName string) (string, error) {
+	build, err := GetKogitoBuild(namespace, buildName)
+	if err != nil {
+		return "", err
+	} else if build == nil {
+		return "", fmt.Errorf("KogitoBuild %s not found in namespace %s", buildName, namespace)
+	}
+	return getKogitoBuildFailureReason(build), nil
+}
+
+func getKogitoBuildFailureReason(build *v1beta1.KogitoBuild) string {
+	if build.Status.Conditions == nil {
+		return ""
+	}
+	failedCondition := apimeta.FindStatusCondition(*build.Status.Conditions, string(api.KogitoBuildFailure))
+	if failedCondition == nil || failedCondition.Status != metav1.ConditionTrue {
+		return ""
+	}
+	return fmt.Sprintf("%s: %s", failedCondition.Reason, failedCondition.Message)
+}
+
+// LogKogitoBuildFailureReasons logs the failure reason of all KogitoBuilds in the namespace
+func LogKogitoBuildFailureReasons(namespace string) error {
+	builds := &v1beta1.KogitoBuildList{}
+	if err := kubernetes.ResourceC(getKubeClient(namespace)).ListWithNamespace(namespace, builds); err != nil {
+		return fmt.Errorf("Error while listing KogitoBuilds in namespace %s: %v", namespace, err)
+	}
+	for _, build := range builds.Items {
+		if reason := getKogitoBuildFailureReason(&build); len(reason) > 0 {
+			GetLogger(namespace).Info("KogitoBuild failure reason", "name", build.Name, "reason", reason)
+		}
+	}
+	return nil
+}
+
 // SetupKogitoBuildImageStreams sets the correct images for the KogitoBuild
 func SetupKogitoBuildImageStreams(kogitoBuild *v1beta1.KogitoBuild) {
 	kogitoBuild.Spec.BuildImage = getKogitoBuildS2IImage()
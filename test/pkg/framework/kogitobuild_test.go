@@ -0,0 +1,43 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"testing"
+
+	"github.com/kiegroup/kogito-operator/api"
+	"github.com/kiegroup/kogito-operator/api/v1beta1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGetKogitoBuildFailureReason(t *testing.T) {
+	failedConditions := []metav1.Condition{
+		{Type: string(api.KogitoBuildSuccessful), Status: metav1.ConditionFalse, Reason: string(api.BuildPhaseFailedReason)},
+		{Type: string(api.KogitoBuildRunning), Status: metav1.ConditionFalse, Reason: string(api.BuildPhaseFailedReason)},
+		{Type: string(api.KogitoBuildFailure), Status: metav1.ConditionTrue, Reason: string(api.BuildPhaseFailedReason), Message: "Maven build failed"},
+	}
+	failedBuild := &v1beta1.KogitoBuild{Status: v1beta1.KogitoBuildStatus{Conditions: &failedConditions}}
+	assert.Equal(t, string(api.BuildPhaseFailedReason)+": Maven build failed", getKogitoBuildFailureReason(failedBuild))
+
+	successfulConditions := []metav1.Condition{
+		{Type: string(api.KogitoBuildSuccessful), Status: metav1.ConditionTrue, Reason: string(api.BuildPhaseCompleteReason)},
+		{Type: string(api.KogitoBuildRunning), Status: metav1.ConditionFalse, Reason: string(api.BuildPhaseCompleteReason)},
+	}
+	successfulBuild := &v1beta1.KogitoBuild{Status: v1beta1.KogitoBuildStatus{Conditions: &successfulConditions}}
+	assert.Empty(t, getKogitoBuildFailureReason(successfulBuild))
+
+	assert.Empty(t, getKogitoBuildFailureReason(&v1beta1.KogitoBuild{}))
+}
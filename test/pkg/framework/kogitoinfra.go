@@ -44,7 +44,7 @@ func InstallKogitoInfraComponent(namespace string, installerType InstallerType,
 }
 
 func crInstallKogitoInfraComponent(infra *v1beta1.KogitoInfra) error {
-	if err := kubernetes.ResourceC(kubeClient).CreateIfNotExists(infra); err != nil {
+	if err := kubernetes.ResourceC(getKubeClient(infra.GetNamespace())).CreateIfNotExists(infra); err != nil {
 		return fmt.Errorf("Error creating KogitoInfra: %v", err)
 	}
 	return nil
@@ -123,7 +123,7 @@ func WaitForKogitoInfraResource(namespace, name string, timeoutInMin int) error
 // retrieves the KogitoInfra resource
 func getKogitoInfraResource(namespace, name string) (*v1beta1.KogitoInfra, error) {
 	infraResource := &v1beta1.KogitoInfra{}
-	if exists, err := kubernetes.ResourceC(kubeClient).FetchWithKey(types.NamespacedName{Name: name, Namespace: namespace}, infraResource); err != nil && !errors.IsNotFound(err) {
+	if exists, err := kubernetes.ResourceC(getKubeClient(namespace)).FetchWithKey(types.NamespacedName{Name: name, Namespace: namespace}, infraResource); err != nil && !errors.IsNotFound(err) {
 		return nil, fmt.Errorf("Error while trying to look for KogitoInfra %s: %v ", name, err)
 	} else if !exists {
 		return nil, nil
@@ -45,7 +45,16 @@ func SetKogitoRuntimeReplicas(namespace, name string, nbPods int) error {
 	}
 	replicas := int32(nbPods)
 	kogitoRuntime.Spec.KogitoServiceSpec.Replicas = &replicas
-	return kubernetes.ResourceC(kubeClient).Update(kogitoRuntime)
+	return kubernetes.ResourceC(getKubeClient(namespace)).Update(kogitoRuntime)
+}
+
+// ListKogitoRuntimeServices returns all KogitoRuntimes deployed in the namespace
+func ListKogitoRuntimeServices(namespace string) ([]v1beta1.KogitoRuntime, error) {
+	kogitoRuntimes := &v1beta1.KogitoRuntimeList{}
+	if err := kubernetes.ResourceC(getKubeClient(namespace)).ListWithNamespace(namespace, kogitoRuntimes); err != nil {
+		return nil, fmt.Errorf("Error while listing KogitoRuntimes in namespace %s: %v", namespace, err)
+	}
+	return kogitoRuntimes.Items, nil
 }
 
 // GetKogitoRuntimeStub Get basic KogitoRuntime stub with all needed fields initialized
@@ -81,7 +90,7 @@ func GetKogitoRuntimeStub(namespace, runtimeType, name, imageTag string) *v1beta
 
 func getKogitoRuntime(namespace, name string) (*v1beta1.KogitoRuntime, error) {
 	kogitoRuntime := &v1beta1.KogitoRuntime{}
-	if exists, err := kubernetes.ResourceC(kubeClient).FetchWithKey(types.NamespacedName{Name: name, Namespace: namespace}, kogitoRuntime); err != nil && !errors.IsNotFound(err) {
+	if exists, err := kubernetes.ResourceC(getKubeClient(namespace)).FetchWithKey(types.NamespacedName{Name: name, Namespace: namespace}, kogitoRuntime); err != nil && !errors.IsNotFound(err) {
 		return nil, fmt.Errorf("Error while trying to look for KogitoRuntime %s: %v ", name, err)
 	} else if errors.IsNotFound(err) || !exists {
 		return nil, nil
@@ -68,17 +68,7 @@ func installOrDeployService(serviceHolder *bddtypes.KogitoServiceHolder, install
 
 // WaitForService waits that the service has a certain number of replicas
 func WaitForService(namespace string, serviceName string, replicas int, timeoutInMin int) error {
-	return WaitForOnOpenshift(namespace, serviceName+" running", timeoutInMin,
-		func() (bool, error) {
-			deployment, err := GetDeployment(namespace, serviceName)
-			if err != nil {
-				return false, err
-			}
-			if deployment == nil {
-				return false, nil
-			}
-			return deployment.Status.Replicas == int32(replicas) && deployment.Status.AvailableReplicas == int32(replicas), nil
-		})
+	return WaitForDeploymentRollout(namespace, serviceName, int32(replicas), timeoutInMin)
 }
 
 // NewObjectMetadata creates a new Object Metadata object.
@@ -150,7 +140,7 @@ func isRuntimeImageInformationSet() bool {
 }
 
 func crInstall(serviceHolder *bddtypes.KogitoServiceHolder) error {
-	if err := kubernetes.ResourceC(kubeClient).CreateIfNotExists(serviceHolder.KogitoService); err != nil {
+	if err := kubernetes.ResourceC(getKubeClient(serviceHolder.GetNamespace())).CreateIfNotExists(serviceHolder.KogitoService); err != nil {
 		return fmt.Errorf("Error creating service: %v", err)
 	}
 	return nil
@@ -185,7 +175,7 @@ func patchKogitoProbes(serviceHolder *bddtypes.KogitoServiceHolder) error {
 
 		// Fetch deployed service
 		var exists bool
-		if exists, err = kubernetes.ResourceC(kubeClient).FetchWithKey(types.NamespacedName{Namespace: serviceHolder.GetNamespace(), Name: serviceHolder.GetName()}, patched); err != nil {
+		if exists, err = kubernetes.ResourceC(getKubeClient(serviceHolder.GetNamespace())).FetchWithKey(types.NamespacedName{Namespace: serviceHolder.GetNamespace(), Name: serviceHolder.GetName()}, patched); err != nil {
 			return fmt.Errorf("Error fetching service %s in namespace %s: %v", serviceHolder.GetName(), serviceHolder.GetNamespace(), err)
 		} else if !exists {
 			return fmt.Errorf("Service %s in namespace %s doesn't exist", serviceHolder.GetName(), serviceHolder.GetNamespace())
@@ -195,7 +185,7 @@ func patchKogitoProbes(serviceHolder *bddtypes.KogitoServiceHolder) error {
 		patched.GetSpec().SetProbes(serviceHolder.GetSpec().GetProbes())
 
 		// Update deployed service
-		if err = kubernetes.ResourceC(kubeClient).Update(patched); err == nil {
+		if err = kubernetes.ResourceC(getKubeClient(serviceHolder.GetNamespace())).Update(patched); err == nil {
 			return nil
 		}
 	}
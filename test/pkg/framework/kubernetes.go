@@ -15,6 +15,7 @@
 package framework
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"sync"
@@ -32,14 +33,20 @@ import (
 	k8sv1beta1 "k8s.io/api/extensions/v1beta1"
 	apiextensionsv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	controllercli "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 var (
 	kubeClient *client.Client
 	mux        = &sync.Mutex{}
+
+	// Map of Kubernetes clients dedicated to namespaces, used instead of the shared one for operations in those namespaces
+	namespaceKubeClients sync.Map
 )
 
 // podErrorReasons contains all the reasons to state a pod in error.
@@ -50,15 +57,47 @@ func InitKubeClient(scheme *runtime.Scheme) error {
 	mux.Lock()
 	defer mux.Unlock()
 	if kubeClient == nil {
-		newClient, err := client.NewClientBuilder(scheme).UseControllerDynamicMapper().WithDiscoveryClient().WithBuildClient().WithKubernetesExtensionClient().Build()
+		newClient, err := NewKubeClient(scheme)
 		if err != nil {
-			return fmt.Errorf("Error initializing kube client: %v", err)
+			return err
 		}
 		kubeClient = newClient
 	}
 	return nil
 }
 
+// NewKubeClient creates a new Kubernetes Client, independent from the shared one
+func NewKubeClient(scheme *runtime.Scheme) (*client.Client, error) {
+	newClient, err := client.NewClientBuilder(scheme).UseControllerDynamicMapper().WithDiscoveryClient().WithBuildClient().WithKubernetesExtensionClient().Build()
+	if err != nil {
+		return nil, fmt.Errorf("Error initializing kube client: %v", err)
+	}
+	return newClient, nil
+}
+
+// GetKubeClient returns the shared Kubernetes Client initialized by InitKubeClient
+func GetKubeClient() *client.Client {
+	return kubeClient
+}
+
+// SetNamespaceKubeClient sets the Kubernetes Client used for all operations in the namespace
+func SetNamespaceKubeClient(namespace string, namespaceClient *client.Client) {
+	namespaceKubeClients.Store(namespace, namespaceClient)
+}
+
+// UnsetNamespaceKubeClient removes the Kubernetes Client set for the namespace, the shared one is used again
+func UnsetNamespaceKubeClient(namespace string) {
+	namespaceKubeClients.Delete(namespace)
+}
+
+// getKubeClient returns the Kubernetes Client set for the namespace, the shared one if none is set
+func getKubeClient(namespace string) *client.Client {
+	if namespaceClient, ok := namespaceKubeClients.Load(namespace); ok {
+		return namespaceClient.(*client.Client)
+	}
+	return kubeClient
+}
+
 // WaitForPodsWithLabel waits for pods with specific label to be available and running
 func WaitForPodsWithLabel(namespace, labelName, labelValue string, numberOfPods, timeoutInMin int) error {
 	return WaitForOnOpenshift(namespace, fmt.Sprintf("Pods with label name '%s' and value '%s' available and running", labelName, labelValue), timeoutInMin,
@@ -72,6 +111,19 @@ func WaitForPodsWithLabel(namespace, labelName, labelValue string, numberOfPods,
 		}, CheckPodsWithLabelInError(namespace, labelName, labelValue))
 }
 
+// WaitForPodReady waits for all pods matching the labels to be ready, having all containers ready and passing readiness probes
+func WaitForPodReady(namespace string, labelSelector map[string]string, timeoutInMin int) error {
+	return WaitForOnOpenshift(namespace, fmt.Sprintf("Pods with labels %v ready", labelSelector), timeoutInMin,
+		func() (bool, error) {
+			pods, err := GetPodsWithLabels(namespace, labelSelector)
+			if err != nil || len(pods.Items) == 0 {
+				return false, err
+			}
+
+			return ArePodsFullyReady(namespace, pods.Items), nil
+		})
+}
+
 // WaitForPodsInNamespace waits for pods in specific namespace to be available and running
 func WaitForPodsInNamespace(namespace string, numberOfPods, timeoutInMin int) error {
 	return WaitForOnOpenshift(namespace, "Pods in namespace available and running", timeoutInMin,
@@ -88,7 +140,7 @@ func WaitForPodsInNamespace(namespace string, numberOfPods, timeoutInMin int) er
 // GetPods retrieves all pods in namespace
 func GetPods(namespace string) (*corev1.PodList, error) {
 	pods := &corev1.PodList{}
-	if err := kubernetes.ResourceC(kubeClient).ListWithNamespace(namespace, pods); err != nil {
+	if err := kubernetes.ResourceC(getKubeClient(namespace)).ListWithNamespace(namespace, pods); err != nil {
 		return nil, err
 	}
 	return pods, nil
@@ -111,7 +163,7 @@ func GetPodsByDeployment(namespace string, dName string) (pods []corev1.Pod, err
 
 	// Fetch all pods in namespace
 	podList := &corev1.PodList{}
-	if err := kubernetes.ResourceC(kubeClient).ListWithNamespace(namespace, podList); err != nil {
+	if err := kubernetes.ResourceC(getKubeClient(namespace)).ListWithNamespace(namespace, podList); err != nil {
 		return nil, err
 	}
 
@@ -130,7 +182,7 @@ func GetPodsByDeployment(namespace string, dName string) (pods []corev1.Pod, err
 // GetActiveReplicaSetByDeployment retrieves active ReplicaSet belonging to a Deployment
 func GetActiveReplicaSetByDeployment(namespace string, dName string) (*apps.ReplicaSet, error) {
 	replicaSets := &apps.ReplicaSetList{}
-	if err := kubernetes.ResourceC(kubeClient).ListWithNamespace(namespace, replicaSets); err != nil {
+	if err := kubernetes.ResourceC(getKubeClient(namespace)).ListWithNamespace(namespace, replicaSets); err != nil {
 		return nil, err
 	}
 
@@ -149,12 +201,53 @@ func GetActiveReplicaSetByDeployment(namespace string, dName string) (*apps.Repl
 // GetPodsWithLabels retrieves pods based on label name and value
 func GetPodsWithLabels(namespace string, labels map[string]string) (*corev1.PodList, error) {
 	pods := &corev1.PodList{}
-	if err := kubernetes.ResourceC(kubeClient).ListWithNamespaceAndLabel(namespace, pods, labels); err != nil {
+	if err := kubernetes.ResourceC(getKubeClient(namespace)).ListWithNamespaceAndLabel(namespace, pods, labels); err != nil {
 		return nil, err
 	}
 	return pods, nil
 }
 
+// CheckPodImageTag returns whether the image of the named container uses the expected tag in all pods matching the label selector
+func CheckPodImageTag(namespace string, labelSelector map[string]string, containerName, expectedTag string) (bool, error) {
+	pods, err := GetPodsWithLabels(namespace, labelSelector)
+	if err != nil {
+		return false, fmt.Errorf("Error while listing pods with labels %v in namespace %s: %v", labelSelector, namespace, err)
+	} else if len(pods.Items) == 0 {
+		return false, fmt.Errorf("No pod with labels %v found in namespace %s", labelSelector, namespace)
+	}
+
+	for _, pod := range pods.Items {
+		container := getContainerByName(pod.Spec.Containers, containerName)
+		if container == nil {
+			return false, fmt.Errorf("Container %s not found in pod %s", containerName, pod.Name)
+		}
+		if tag := getImageTag(container.Image); tag != expectedTag {
+			GetLogger(namespace).Debug("Unexpected image tag", "pod", pod.Name, "image", container.Image, "expectedTag", expectedTag)
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func getContainerByName(containers []corev1.Container, containerName string) *corev1.Container {
+	for i := range containers {
+		if containers[i].Name == containerName {
+			return &containers[i]
+		}
+	}
+	return nil
+}
+
+// getImageTag returns the tag of the image, ignoring the registry port and digest
+func getImageTag(image string) string {
+	image = strings.Split(image, "@")[0]
+	lastSlash := strings.LastIndex(image, "/")
+	if lastColon := strings.LastIndex(image, ":"); lastColon > lastSlash {
+		return image[lastColon+1:]
+	}
+	return ""
+}
+
 // CheckPodsAreReady returns true if all pods are ready
 func CheckPodsAreReady(pods *corev1.PodList) bool {
 	for _, pod := range pods.Items {
@@ -180,25 +273,80 @@ func IsPodStatusConditionReady(pod *corev1.Pod) bool {
 	return false
 }
 
+// IsPodReady returns true if pod has Ready condition set to true, meaning its readiness probes are passing
+func IsPodReady(pod *corev1.Pod) bool {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// IsPodFullyReady returns true if all pod's containers are ready and pod has Ready condition set to true
+func IsPodFullyReady(pod *corev1.Pod) bool {
+	for _, containerStatus := range pod.Status.ContainerStatuses {
+		if !containerStatus.Ready {
+			return false
+		}
+	}
+	return IsPodReady(pod)
+}
+
+// ArePodsFullyReady returns true if all pods are fully ready
+func ArePodsFullyReady(namespace string, pods []corev1.Pod) bool {
+	for _, pod := range pods {
+		if !IsPodFullyReady(&pod) {
+			GetLogger(namespace).Debug("Pod is not ready yet", "pod", pod.GetName())
+			return false
+		}
+	}
+	return true
+}
+
 // WaitForDeploymentRunning waits for a deployment to be running, with a specific number of pod
 func WaitForDeploymentRunning(namespace, dName string, podNb int, timeoutInMin int) error {
-	return WaitForOnOpenshift(namespace, fmt.Sprintf("Deployment %s running", dName), timeoutInMin,
+	return WaitForDeploymentRollout(namespace, dName, int32(podNb), timeoutInMin)
+}
+
+// WaitForDeploymentRollout waits for a deployment to be rolled out, meaning all its replicas are updated, ready and available
+func WaitForDeploymentRollout(namespace, deploymentName string, expectedReplicas int32, timeoutInMin int) error {
+	return WaitForOnOpenshift(namespace, fmt.Sprintf("Deployment %s rolled out with %d replicas", deploymentName, expectedReplicas), timeoutInMin,
 		func() (bool, error) {
-			if dc, err := GetDeployment(namespace, dName); err != nil {
+			deployment, err := GetDeployment(namespace, deploymentName)
+			if err != nil {
 				return false, err
-			} else if dc == nil {
+			} else if deployment == nil {
 				return false, nil
-			} else {
-				GetLogger(namespace).Debug("Deployment has", "available replicas", dc.Status.AvailableReplicas)
-				return dc.Status.Replicas == int32(podNb) && dc.Status.AvailableReplicas == int32(podNb), nil
 			}
+			GetLogger(namespace).Debug("Deployment has", "replicas", deployment.Status.Replicas, "updated replicas", deployment.Status.UpdatedReplicas, "ready replicas", deployment.Status.ReadyReplicas, "available replicas", deployment.Status.AvailableReplicas)
+			return isDeploymentRolledOut(deployment, expectedReplicas), nil
 		})
 }
 
+// isDeploymentRolledOut returns whether all the expected replicas of the deployment are updated, ready and available
+func isDeploymentRolledOut(deployment *apps.Deployment, expectedReplicas int32) bool {
+	// Total replicas are checked too so that pods of a previous rollout are not taken into account
+	return deployment.Status.Replicas == expectedReplicas &&
+		deployment.Status.UpdatedReplicas == expectedReplicas &&
+		deployment.Status.ReadyReplicas == expectedReplicas &&
+		deployment.Status.AvailableReplicas == expectedReplicas
+}
+
+// isDeploymentRolledOutToSpec returns whether the deployment is rolled out with the number of replicas set in its spec
+func isDeploymentRolledOutToSpec(deployment *apps.Deployment) bool {
+	// Kubernetes defaults the number of replicas to 1 when not set
+	expectedReplicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		expectedReplicas = *deployment.Spec.Replicas
+	}
+	return isDeploymentRolledOut(deployment, expectedReplicas)
+}
+
 // GetDeployment retrieves deployment with specified name in namespace
 func GetDeployment(namespace, deploymentName string) (*apps.Deployment, error) {
 	deployment := &apps.Deployment{}
-	if exists, err := kubernetes.ResourceC(kubeClient).FetchWithKey(types.NamespacedName{Name: deploymentName, Namespace: namespace}, deployment); err != nil {
+	if exists, err := kubernetes.ResourceC(getKubeClient(namespace)).FetchWithKey(types.NamespacedName{Name: deploymentName, Namespace: namespace}, deployment); err != nil {
 		return nil, err
 	} else if !exists {
 		return nil, nil
@@ -215,7 +363,7 @@ func LoadResource(namespace, uri string, resourceRef kubernetes.ResourceObject,
 		return fmt.Errorf("Unable to read from URI %s: %v", uri, err)
 	}
 
-	if err = kubernetes.ResourceC(kubeClient).CreateFromYamlContent(data, namespace, resourceRef, beforeCreate); err != nil {
+	if err = kubernetes.ResourceC(getKubeClient(namespace)).CreateFromYamlContent(data, namespace, resourceRef, beforeCreate); err != nil {
 		return fmt.Errorf("Error while creating resources from file '%s': %v ", uri, err)
 	}
 	return nil
@@ -282,7 +430,7 @@ func checkAllPodsContainingTextInLog(namespace string, pods []corev1.Pod, contai
 }
 
 func isPodContainingTextInLog(namespace string, pod *corev1.Pod, containerName, text string) (bool, error) {
-	log, err := kubernetes.PodC(kubeClient).GetLogs(namespace, pod.GetName(), containerName)
+	log, err := kubernetes.PodC(getKubeClient(namespace)).GetLogs(namespace, pod.GetName(), containerName)
 	return strings.Contains(log, text), err
 }
 
@@ -296,29 +444,96 @@ func IsCrdAvailable(crdName string) (bool, error) {
 	return kubernetes.ResourceC(kubeClient).Fetch(crdEntity)
 }
 
+// CheckOperatorOwnsCRD returns whether the CRD owned by an operator is registered and established in the cluster
+func CheckOperatorOwnsCRD(crdName string) (bool, error) {
+	crd := &apiextensionsv1beta1.CustomResourceDefinition{}
+	if exists, err := kubernetes.ResourceC(kubeClient).FetchWithKey(types.NamespacedName{Name: crdName}, crd); err != nil {
+		return false, fmt.Errorf("Error while trying to look for CRD %s: %v ", crdName, err)
+	} else if !exists {
+		return false, nil
+	}
+
+	for _, condition := range crd.Status.Conditions {
+		if condition.Type == apiextensionsv1beta1.Established {
+			return condition.Status == apiextensionsv1beta1.ConditionTrue, nil
+		}
+	}
+	return false, nil
+}
+
+// WaitForCRDAvailable waits for the CRD to be registered and established in the cluster, and its resource to be served in API groups
+func WaitForCRDAvailable(crdName string, timeoutInMin int) error {
+	return WaitForOnOpenshift(mainLoggerName, fmt.Sprintf("CRD %s available", crdName), timeoutInMin,
+		func() (bool, error) {
+			return isCRDAvailable(crdName)
+		})
+}
+
+// isCRDAvailable checks whether the CRD is established in the cluster and its resource is served in API groups
+func isCRDAvailable(crdName string) (bool, error) {
+	if established, err := CheckOperatorOwnsCRD(crdName); err != nil || !established {
+		return false, err
+	}
+	return isCRDServed(crdName)
+}
+
+// isCRDServed checks using discovery API whether the resource defined by the CRD, named <plural>.<group>, is served by any version of its API group
+func isCRDServed(crdName string) (bool, error) {
+	separatorIndex := strings.Index(crdName, ".")
+	if separatorIndex < 0 {
+		return false, fmt.Errorf("Invalid CRD name %s, expected <plural>.<group>", crdName)
+	}
+	plural, group := crdName[:separatorIndex], crdName[separatorIndex+1:]
+
+	if kubeClient.Discovery == nil {
+		return false, fmt.Errorf("Discovery API isn't available to check CRD %s", crdName)
+	}
+	groups, err := kubeClient.Discovery.ServerGroups()
+	if err != nil {
+		return false, fmt.Errorf("Error while retrieving server API groups: %v", err)
+	}
+	for _, apiGroup := range groups.Groups {
+		if apiGroup.Name != group {
+			continue
+		}
+		for _, version := range apiGroup.Versions {
+			resources, err := kubeClient.Discovery.ServerResourcesForGroupVersion(version.GroupVersion)
+			if err != nil {
+				return false, fmt.Errorf("Error while retrieving resources of API group version %s: %v", version.GroupVersion, err)
+			}
+			for _, resource := range resources.APIResources {
+				if resource.Name == plural {
+					return true, nil
+				}
+			}
+		}
+	}
+	return false, nil
+}
+
 // CreateObject creates object
 func CreateObject(o kubernetes.ResourceObject) error {
-	return kubernetes.ResourceC(kubeClient).Create(o)
+	return kubernetes.ResourceC(getKubeClient(o.GetNamespace())).Create(o)
 }
 
 // GetObjectsInNamespace returns list of objects in specific namespace based on type
 func GetObjectsInNamespace(namespace string, list runtime.Object) error {
-	return kubernetes.ResourceC(kubeClient).ListWithNamespace(namespace, list)
+	return kubernetes.ResourceC(getKubeClient(namespace)).ListWithNamespace(namespace, list)
 }
 
 // GetObjectWithKey returns object matching provided key
 func GetObjectWithKey(key types.NamespacedName, o kubernetes.ResourceObject) (exists bool, err error) {
-	return kubernetes.ResourceC(kubeClient).FetchWithKey(key, o)
+	return kubernetes.ResourceC(getKubeClient(key.Namespace)).FetchWithKey(key, o)
 }
 
 // UpdateObject updates object
 func UpdateObject(o kubernetes.ResourceObject) error {
-	return kubernetes.ResourceC(kubeClient).Update(o)
+	return kubernetes.ResourceC(getKubeClient(o.GetNamespace())).Update(o)
 }
 
 // DeleteObject deletes object
 func DeleteObject(o kubernetes.ResourceObject) error {
-	return kubernetes.ResourceC(kubeClient).Delete(o)
+	return kubernetes.ResourceC(getKubeClient(o.GetNamespace())).Delete(o)
 }
 
 // CreateSecret creates a new secret
@@ -334,7 +549,7 @@ func CreateSecret(namespace, name string, secretContent map[string]string) error
 		StringData: secretContent,
 	}
 
-	return kubernetes.ResourceC(kubeClient).Create(secret)
+	return kubernetes.ResourceC(getKubeClient(namespace)).Create(secret)
 }
 
 // CheckPodHasImagePullSecretWithPrefix checks that a pod has an image pull secret starting with the given prefix
@@ -395,6 +610,22 @@ func CheckPodsInNamespaceInError(namespace string) func() (bool, error) {
 	}
 }
 
+// CheckPodsRestartCount returns false and an error if any container of the pods with given labels restarted more than maxRestarts times
+func CheckPodsRestartCount(namespace string, labels map[string]string, maxRestarts int32) (bool, error) {
+	pods, err := GetPodsWithLabels(namespace, labels)
+	if err != nil {
+		return false, err
+	}
+	for _, pod := range pods.Items {
+		for _, status := range pod.Status.ContainerStatuses {
+			if status.RestartCount > maxRestarts {
+				return false, fmt.Errorf("Container %s of pod %s restarted %d times, more than the allowed %d restarts", status.Name, pod.Name, status.RestartCount, maxRestarts)
+			}
+		}
+	}
+	return true, nil
+}
+
 func checkPodsInError(pods []corev1.Pod) (bool, error) {
 	for _, pod := range pods {
 		if hasErrors, err := isPodInError(&pod); hasErrors {
@@ -438,7 +669,7 @@ func checkPodContainerHasEnvVariableWithValue(pod *corev1.Pod, containerName, en
 // GetIngressURI returns the ingress URI
 func GetIngressURI(namespace, serviceName string) (string, error) {
 	ingress := &k8sv1beta1.Ingress{}
-	if exists, err := kubernetes.ResourceC(kubeClient).FetchWithKey(types.NamespacedName{Name: serviceName, Namespace: namespace}, ingress); err != nil {
+	if exists, err := kubernetes.ResourceC(getKubeClient(namespace)).FetchWithKey(types.NamespacedName{Name: serviceName, Namespace: namespace}, ingress); err != nil {
 		return "", err
 	} else if !exists {
 		return "", fmt.Errorf("Ingress %s does not exist in namespace %s", serviceName, namespace)
@@ -485,7 +716,7 @@ func ExposeServiceOnKubernetes(namespace string, service api.KogitoService) erro
 			},
 		},
 	}
-	return kubernetes.ResourceC(kubeClient).Create(&ingress)
+	return kubernetes.ResourceC(getKubeClient(namespace)).Create(&ingress)
 }
 
 // WaitForOnKubernetes is a specific method
@@ -506,7 +737,7 @@ func IsOpenshift() bool {
 // GetService return Service based on namespace and name
 func GetService(namespace, name string) (*corev1.Service, error) {
 	service := &corev1.Service{}
-	if exits, err := kubernetes.ResourceC(kubeClient).FetchWithKey(types.NamespacedName{Name: name, Namespace: namespace}, service); err != nil {
+	if exits, err := kubernetes.ResourceC(getKubeClient(namespace)).FetchWithKey(types.NamespacedName{Name: name, Namespace: namespace}, service); err != nil {
 		return nil, err
 	} else if !exits {
 		return nil, fmt.Errorf("Service with name %s doesn't exist in given namespace %s", name, namespace)
@@ -543,3 +774,48 @@ func GetClusterRoleBinding(name string) (*rbac.ClusterRoleBinding, error) {
 	}
 	return clusterRoleBinding, nil
 }
+
+// GetNamespacedResourceCount returns the number of resources of given kind and API group in the namespace, the preferred group version is resolved using discovery API
+func GetNamespacedResourceCount(namespace, resourceKind, resourceGroup string) (int, error) {
+	gvk, err := resolvePreferredGroupVersionKind(resourceKind, resourceGroup)
+	if err != nil {
+		return 0, err
+	}
+
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+	if err := getKubeClient(namespace).ControlCli.List(context.TODO(), list, controllercli.InNamespace(namespace)); err != nil {
+		return 0, fmt.Errorf("Error while listing %s resources in namespace %s: %v", resourceKind, namespace, err)
+	}
+	return len(list.Items), nil
+}
+
+// resolvePreferredGroupVersionKind looks for the namespaced resource kind in the preferred version of the API group
+func resolvePreferredGroupVersionKind(resourceKind, resourceGroup string) (schema.GroupVersionKind, error) {
+	if kubeClient.Discovery == nil {
+		return schema.GroupVersionKind{}, fmt.Errorf("Discovery API isn't available to resolve %s resources", resourceKind)
+	}
+	groups, err := kubeClient.Discovery.ServerGroups()
+	if err != nil {
+		return schema.GroupVersionKind{}, fmt.Errorf("Error while retrieving server API groups: %v", err)
+	}
+
+	for _, group := range groups.Groups {
+		if group.Name != resourceGroup {
+			continue
+		}
+		groupVersion := group.PreferredVersion.GroupVersion
+		resources, err := kubeClient.Discovery.ServerResourcesForGroupVersion(groupVersion)
+		if err != nil {
+			return schema.GroupVersionKind{}, fmt.Errorf("Error while retrieving resources of API group version %s: %v", groupVersion, err)
+		}
+		for _, resource := range resources.APIResources {
+			// Subresources share the kind of their parent resource
+			if resource.Kind == resourceKind && resource.Namespaced && !strings.Contains(resource.Name, "/") {
+				return schema.GroupVersionKind{Group: group.Name, Version: group.PreferredVersion.Version, Kind: resourceKind}, nil
+			}
+		}
+		return schema.GroupVersionKind{}, fmt.Errorf("Namespaced resource kind %s not found in API group version %s", resourceKind, groupVersion)
+	}
+	return schema.GroupVersionKind{}, fmt.Errorf("API group %s not found in cluster", resourceGroup)
+}
@@ -0,0 +1,45 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apps "k8s.io/api/apps/v1"
+)
+
+func Test_isDeploymentRolledOutToSpec(t *testing.T) {
+	replicas := int32(2)
+	deployment := &apps.Deployment{
+		Spec: apps.DeploymentSpec{Replicas: &replicas},
+		Status: apps.DeploymentStatus{
+			Replicas:          2,
+			UpdatedReplicas:   2,
+			ReadyReplicas:     2,
+			AvailableReplicas: 1,
+		},
+	}
+	assert.False(t, isDeploymentRolledOutToSpec(deployment))
+
+	deployment.Status.AvailableReplicas = 2
+	assert.True(t, isDeploymentRolledOutToSpec(deployment))
+
+	// Replicas default to 1 when not set in the spec
+	deployment.Spec.Replicas = nil
+	assert.False(t, isDeploymentRolledOutToSpec(deployment))
+	deployment.Status = apps.DeploymentStatus{Replicas: 1, UpdatedReplicas: 1, ReadyReplicas: 1, AvailableReplicas: 1}
+	assert.True(t, isDeploymentRolledOutToSpec(deployment))
+}
@@ -40,6 +40,10 @@ const (
 	defaultLogFolder       = "logs"
 	logSuffix              = ".log"
 	defaultResultsFileName = "results.csv"
+	bddTimingsFileName     = "bdd-timings.ndjson"
+
+	// mainLoggerName name of the logger used for actions not related to any namespace
+	mainLoggerName = "main"
 )
 
 var (
@@ -48,11 +52,19 @@ var (
 	monitoredNamespaces sync.Map
 
 	loggerOpts = make(map[string]*Opts)
+
+	bddTimingsMutex sync.Mutex
 )
 
+// ScenarioDuration is the recorded duration of a BDD scenario
+type ScenarioDuration struct {
+	ScenarioName string        `json:"scenarioName"`
+	Duration     time.Duration `json:"duration"`
+}
+
 // GetMainLogger returns the main logger
 func GetMainLogger() Logger {
-	return GetLogger("main")
+	return GetLogger(mainLoggerName)
 }
 
 // GetLogger retrieves the logger for a namespace
@@ -189,6 +201,54 @@ func ReportPerformanceMetric(metric, value, unit string) {
 	}
 }
 
+// RecordBDDScenarioDuration appends the scenario duration as a JSON line to the timings file in the log folder
+func RecordBDDScenarioDuration(scenarioName string, duration time.Duration) {
+	line, err := json.Marshal(ScenarioDuration{ScenarioName: scenarioName, Duration: duration})
+	if err != nil {
+		GetMainLogger().Error(err, "Error marshalling scenario duration", "scenario", scenarioName)
+		return
+	}
+
+	// Scenarios can finish concurrently, keep each line whole
+	bddTimingsMutex.Lock()
+	defer bddTimingsMutex.Unlock()
+	timingsFile, err := os.OpenFile(GetLogFolder()+"/"+bddTimingsFileName, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		GetMainLogger().Error(err, "Error opening the BDD timings file")
+		return
+	}
+	defer func() {
+		if err := timingsFile.Close(); err != nil {
+			GetMainLogger().Error(err, "Error while closing the BDD timings file")
+		}
+	}()
+
+	if _, err := timingsFile.Write(append(line, '\n')); err != nil {
+		GetMainLogger().Error(err, "Error writing scenario duration to the BDD timings file", "scenario", scenarioName)
+	}
+}
+
+// ParseBDDTimings reads the scenario durations recorded in the given timings file
+func ParseBDDTimings(filePath string) ([]ScenarioDuration, error) {
+	content, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("Error while reading BDD timings file %s: %v", filePath, err)
+	}
+
+	var durations []ScenarioDuration
+	for i, line := range strings.Split(string(content), "\n") {
+		if len(strings.TrimSpace(line)) == 0 {
+			continue
+		}
+		scenarioDuration := ScenarioDuration{}
+		if err := json.Unmarshal([]byte(line), &scenarioDuration); err != nil {
+			return nil, fmt.Errorf("Error while parsing line %d of BDD timings file %s: %v", i+1, filePath, err)
+		}
+		durations = append(durations, scenarioDuration)
+	}
+	return durations, nil
+}
+
 func isNamespaceMonitored(namespace string) bool {
 	_, exists := monitoredNamespaces.Load(namespace)
 	return exists
@@ -276,7 +336,7 @@ func storeContainerLogWithFollow(namespace, podName, containerName string) {
 
 // Log is returned once container is terminated
 func getContainerLogWithFollow(namespace, podName, containerName string) (string, error) {
-	return kubernetes.PodC(kubeClient).GetLogsWithFollow(namespace, podName, containerName)
+	return kubernetes.PodC(getKubeClient(namespace)).GetLogsWithFollow(namespace, podName, containerName)
 }
 
 func isContainerLoggingFinished(namespace, podName, containerName string) bool {
@@ -339,7 +399,7 @@ func storeContainerLog(namespace string, podName, containerName string) {
 
 // GetContainerLog exported for Zookeeper workaround, can be unexported once https://github.com/strimzi/strimzi-kafka-operator/issues/3092 is fixed
 func GetContainerLog(namespace, podName, containerName string) (string, error) {
-	return kubernetes.PodC(kubeClient).GetLogs(namespace, podName, containerName)
+	return kubernetes.PodC(getKubeClient(namespace)).GetLogs(namespace, podName, containerName)
 }
 
 func getMonitoredNamespace(namespace string) *monitoredNamespace {
@@ -399,7 +459,7 @@ var eventKeys = []string{
 
 // BumpEvents will bump all events into events.log file
 func BumpEvents(namespace string) error {
-	eventList, err := kubernetes.EventC(kubeClient).GetEvents(namespace)
+	eventList, err := kubernetes.EventC(getKubeClient(namespace)).GetEvents(namespace)
 	if err != nil {
 		return fmt.Errorf("Error retrieving events from namespace %s: %v", namespace, err)
 	}
@@ -453,7 +513,7 @@ func LogKubernetesObjects(namespace string, runtimeObjects ...runtime.Object) er
 		objectName := reflect.TypeOf(runtimeObject).Elem().Name()
 
 		// Fetch list
-		err := kubernetes.ResourceC(kubeClient).ListWithNamespace(namespace, runtimeObject)
+		err := kubernetes.ResourceC(getKubeClient(namespace)).ListWithNamespace(namespace, runtimeObject)
 		if err != nil {
 			GetLogger(namespace).Warn("Error logging Kubernetes objects", "namespace", namespace, "error message", err.Error())
 			continue
@@ -15,8 +15,11 @@
 package framework
 
 import (
+	"encoding/xml"
 	"fmt"
 	"io/ioutil"
+	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/kiegroup/kogito-operator/test/pkg/config"
@@ -50,6 +53,8 @@ type MavenCommand interface {
 	Profiles(profiles ...string) MavenCommand
 	// Options adds additional command line options for the Maven command
 	Options(options ...string) MavenCommand
+	// Repository adds an artifact repository into the settings.xml used by the Maven command
+	Repository(repoID, repoURL string) MavenCommand
 }
 
 type mavenCommandStruct struct {
@@ -58,6 +63,7 @@ type mavenCommandStruct struct {
 
 	profiles        []string
 	otherOptions    []string
+	repositories    []mavenRepository
 	skipTests       bool
 	updateArtifacts bool
 }
@@ -92,6 +98,11 @@ func (mvnCmd *mavenCommandStruct) Options(options ...string) MavenCommand {
 	return mvnCmd
 }
 
+func (mvnCmd *mavenCommandStruct) Repository(repoID, repoURL string) MavenCommand {
+	mvnCmd.repositories = append(mvnCmd.repositories, mavenRepository{ID: repoID, URL: repoURL})
+	return mvnCmd
+}
+
 func (mvnCmd *mavenCommandStruct) Execute(targets ...string) (string, error) {
 	var args []string
 
@@ -152,6 +163,11 @@ func (mvnCmd *mavenCommandStruct) setSettingsXML() error {
 		settings.AddRepository(mainRepositoryID, defaultJBossRepository, false)
 	}
 
+	// Setup repositories requested for this command, accessed directly even if a mirror is defined
+	for _, repo := range mvnCmd.repositories {
+		settings.AddRepository(repo.ID, repo.URL, true)
+	}
+
 	// Create settings.xml in directory
 	if err := ioutil.WriteFile(fmt.Sprintf("%s/settings.xml", mvnCmd.directory), []byte(settings.Generate()), 0644); err != nil {
 		return err
@@ -214,6 +230,61 @@ func (settings *mavenSettings) Generate() string {
 	return settingsContent
 }
 
+// GetDefaultMavenLocalRepository returns the path of the default Maven local repository of the current user
+func GetDefaultMavenLocalRepository() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("Error while resolving user home directory: %v", err)
+	}
+	return filepath.Join(homeDir, ".m2", "repository"), nil
+}
+
+// GetMavenLocalRepositorySize returns the total size in bytes of all files stored in the Maven local repository
+func GetMavenLocalRepositorySize(repoPath string) (int64, error) {
+	var size int64
+	err := filepath.Walk(repoPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			size += info.Size()
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("Error while computing size of Maven local repository %s: %v", repoPath, err)
+	}
+	return size, nil
+}
+
+type mavenProject struct {
+	Version string `xml:"version"`
+	Parent  struct {
+		Version string `xml:"version"`
+	} `xml:"parent"`
+}
+
+// ReadMavenProjectVersion returns the version of the Maven project defined in the given pom.xml, inherited from parent if not defined
+func ReadMavenProjectVersion(pomPath string) (string, error) {
+	content, err := ioutil.ReadFile(pomPath)
+	if err != nil {
+		return "", fmt.Errorf("Error while reading pom file %s: %v", pomPath, err)
+	}
+
+	project := &mavenProject{}
+	if err := xml.Unmarshal(content, project); err != nil {
+		return "", fmt.Errorf("Error while parsing pom file %s: %v", pomPath, err)
+	}
+
+	if version := strings.TrimSpace(project.Version); len(version) > 0 {
+		return version, nil
+	}
+	if version := strings.TrimSpace(project.Parent.Version); len(version) > 0 {
+		return version, nil
+	}
+	return "", fmt.Errorf("No project version found in pom file %s", pomPath)
+}
+
 const (
 	repositoryXMLContentTpl = `
       <id>%s</id>
@@ -0,0 +1,73 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type settingsXML struct {
+	Mirrors []struct {
+		MirrorOf string `xml:"mirrorOf"`
+	} `xml:"mirrors>mirror"`
+	Profiles []struct {
+		ID                 string          `xml:"id"`
+		Repositories       []repositoryXML `xml:"repositories>repository"`
+		PluginRepositories []repositoryXML `xml:"pluginRepositories>pluginRepository"`
+	} `xml:"profiles>profile"`
+	ActiveProfiles []string `xml:"activeProfiles>activeProfile"`
+}
+
+type repositoryXML struct {
+	ID  string `xml:"id"`
+	URL string `xml:"url"`
+}
+
+func TestMavenSettings_GenerateWithRepository(t *testing.T) {
+	settings := &mavenSettings{}
+	settings.SetMirrorURL("https://mirror.example.com/maven").
+		AddRepository(mainRepositoryID, defaultJBossRepository, false).
+		AddRepository("custom", "https://repo.example.com/maven", true)
+
+	parsed := &settingsXML{}
+	err := xml.Unmarshal([]byte(settings.Generate()), parsed)
+	assert.NoError(t, err)
+
+	expectedRepositories := []repositoryXML{
+		{ID: mainRepositoryID, URL: defaultJBossRepository},
+		{ID: "custom", URL: "https://repo.example.com/maven"},
+	}
+	assert.Len(t, parsed.Profiles, 1)
+	assert.Equal(t, expectedRepositories, parsed.Profiles[0].Repositories)
+	assert.Equal(t, expectedRepositories, parsed.Profiles[0].PluginRepositories)
+	assert.Equal(t, []string{parsed.Profiles[0].ID}, parsed.ActiveProfiles)
+
+	assert.Len(t, parsed.Mirrors, 1)
+	assert.Equal(t, "external:*,!custom", parsed.Mirrors[0].MirrorOf)
+}
+
+func TestMavenSettings_GenerateWithoutRepository(t *testing.T) {
+	settings := &mavenSettings{}
+
+	parsed := &settingsXML{}
+	err := xml.Unmarshal([]byte(settings.Generate()), parsed)
+	assert.NoError(t, err)
+
+	assert.Empty(t, parsed.Profiles)
+	assert.Empty(t, parsed.Mirrors)
+}
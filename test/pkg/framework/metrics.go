@@ -0,0 +1,258 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbac "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/transport/spdy"
+	controllercliconfig "sigs.k8s.io/controller-runtime/pkg/client/config"
+)
+
+const (
+	// kogitoOperatorMetricsPort exposed by the kube-rbac-proxy sidecar of the Kogito operator pod, the manager serves metrics on localhost only
+	kogitoOperatorMetricsPort = 8443
+	kogitoOperatorMetricsPath = "metrics"
+
+	// kogitoOperatorMetricsReaderClusterRole ClusterRole shipped with Kogito operator allowing to read its metrics
+	kogitoOperatorMetricsReaderClusterRole = "kogito-operator-metrics-reader"
+	// kogitoOperatorMetricsReaderName name of the ServiceAccount used by BDD tests to read Kogito operator metrics
+	kogitoOperatorMetricsReaderName       = "kogito-operator-bdd-metrics-reader"
+	kogitoOperatorMetricsTokenExpirationS = 600
+	// kogitoOperatorMetricsAuthorizationTimeout time given to kube-rbac-proxy to authorize a newly bound metrics reader
+	kogitoOperatorMetricsAuthorizationTimeout = 1 * time.Minute
+)
+
+var (
+	// Namespaces where the metrics reader ServiceAccount and its ClusterRoleBinding were created
+	metricsReaderNamespaces sync.Map
+)
+
+// GetKogitoOperatorMetrics retrieves metrics exposed by Kogito operator pod, mapping each metric series to its value.
+// Metrics with labels are keyed by their name followed by the labels, for example `controller_runtime_reconcile_total{controller="kogitoruntime",result="success"}`
+// Metrics are not retrieved through the API server pod proxy: kube-rbac-proxy requires a bearer token allowed to read metrics, which the proxy doesn't forward.
+// The pod port is forwarded locally instead and requested with the token of the metrics reader ServiceAccount, see CreateKogitoOperatorMetricsReader.
+func GetKogitoOperatorMetrics(namespace string) (map[string]float64, error) {
+	pods, err := GetPodsByDeployment(namespace, kogitoOperatorDeploymentName)
+	if err != nil {
+		return nil, fmt.Errorf("Error while trying to retrieve Kogito operator pods: %v", err)
+	} else if len(pods) == 0 {
+		return nil, fmt.Errorf("No Kogito operator pod found in namespace %s", namespace)
+	}
+	podName := pods[0].GetName()
+
+	if err := CreateKogitoOperatorMetricsReader(namespace); err != nil {
+		return nil, err
+	}
+	token, err := getKogitoOperatorMetricsReaderToken(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	localPort, stopForwarding, err := forwardPodPort(namespace, podName, kogitoOperatorMetricsPort)
+	if err != nil {
+		return nil, err
+	}
+	defer stopForwarding()
+
+	GetLogger(namespace).Debug("Retrieving Kogito operator metrics", "pod", podName, "localPort", localPort)
+	requestInfo := NewGETHTTPRequestInfo(fmt.Sprintf("https://localhost:%d", localPort), kogitoOperatorMetricsPath)
+	requestInfo.Token = token
+	// kube-rbac-proxy serves a self-signed certificate
+	requestInfo.Unsecure = true
+
+	var content []byte
+	// Permissions of a newly bound ServiceAccount can take some time to be taken into account by kube-rbac-proxy
+	err = WaitFor(namespace, fmt.Sprintf("Kogito operator pod %s metrics authorized", podName), kogitoOperatorMetricsAuthorizationTimeout,
+		func() (bool, error) {
+			response, err := ExecuteHTTPRequestC(&http.Client{}, namespace, requestInfo)
+			if err != nil {
+				return false, fmt.Errorf("Error while retrieving metrics of Kogito operator pod %s: %v", podName, err)
+			}
+			defer response.Body.Close()
+			if response.StatusCode == http.StatusUnauthorized || response.StatusCode == http.StatusForbidden {
+				GetLogger(namespace).Debug("Kogito operator metrics not authorized yet", "pod", podName, "statusCode", response.StatusCode)
+				return false, nil
+			} else if response.StatusCode != http.StatusOK {
+				return false, fmt.Errorf("Retrieving metrics of Kogito operator pod %s failed with status code %d", podName, response.StatusCode)
+			}
+			if content, err = ioutil.ReadAll(response.Body); err != nil {
+				return false, fmt.Errorf("Error while reading metrics of Kogito operator pod %s: %v", podName, err)
+			}
+			return true, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return parsePrometheusMetrics(string(content))
+}
+
+// CreateKogitoOperatorMetricsReader creates, once per namespace, the ServiceAccount allowed to read Kogito operator metrics, bound cluster wide to the metrics reader ClusterRole
+func CreateKogitoOperatorMetricsReader(namespace string) error {
+	if _, created := metricsReaderNamespaces.Load(namespace); created {
+		return nil
+	}
+
+	serviceAccount := &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{Name: kogitoOperatorMetricsReaderName, Namespace: namespace},
+	}
+	if err := kubernetes.ResourceC(getKubeClient(namespace)).CreateIfNotExists(serviceAccount); err != nil {
+		return fmt.Errorf("Error while creating ServiceAccount %s: %v", kogitoOperatorMetricsReaderName, err)
+	}
+
+	binding := &rbac.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: getKogitoOperatorMetricsReaderBindingName(namespace)},
+		RoleRef: rbac.RoleRef{
+			APIGroup: rbac.GroupName,
+			Kind:     "ClusterRole",
+			Name:     kogitoOperatorMetricsReaderClusterRole,
+		},
+		Subjects: []rbac.Subject{
+			{Kind: rbac.ServiceAccountKind, Name: kogitoOperatorMetricsReaderName, Namespace: namespace},
+		},
+	}
+	// Registered before creating the binding so that teardown removes the ServiceAccount even if binding creation fails
+	metricsReaderNamespaces.Store(namespace, true)
+	if err := kubernetes.ResourceC(getKubeClient(namespace)).CreateIfNotExists(binding); err != nil {
+		return fmt.Errorf("Error while creating ClusterRoleBinding %s: %v", binding.Name, err)
+	}
+	return nil
+}
+
+// DeleteKogitoOperatorMetricsReader deletes the metrics reader ServiceAccount and its ClusterRoleBinding, which is not removed with the namespace, if they were created in the namespace
+func DeleteKogitoOperatorMetricsReader(namespace string) error {
+	if _, created := metricsReaderNamespaces.Load(namespace); !created {
+		return nil
+	}
+
+	binding := &rbac.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: getKogitoOperatorMetricsReaderBindingName(namespace)},
+	}
+	if err := kubernetes.ResourceC(getKubeClient(namespace)).Delete(binding); err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("Error while deleting ClusterRoleBinding %s: %v", binding.Name, err)
+	}
+	serviceAccount := &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{Name: kogitoOperatorMetricsReaderName, Namespace: namespace},
+	}
+	if err := kubernetes.ResourceC(getKubeClient(namespace)).Delete(serviceAccount); err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("Error while deleting ServiceAccount %s: %v", kogitoOperatorMetricsReaderName, err)
+	}
+	metricsReaderNamespaces.Delete(namespace)
+	return nil
+}
+
+// getKogitoOperatorMetricsReaderToken returns a short-lived token of the ServiceAccount allowed to read Kogito operator metrics
+func getKogitoOperatorMetricsReaderToken(namespace string) (string, error) {
+	expirationSeconds := int64(kogitoOperatorMetricsTokenExpirationS)
+	tokenRequest := &authenticationv1.TokenRequest{
+		Spec: authenticationv1.TokenRequestSpec{ExpirationSeconds: &expirationSeconds},
+	}
+	tokenRequest, err := getKubeClient(namespace).KubernetesExtensionCli.CoreV1().ServiceAccounts(namespace).CreateToken(context.TODO(), kogitoOperatorMetricsReaderName, tokenRequest, metav1.CreateOptions{})
+	if err != nil {
+		return "", fmt.Errorf("Error while requesting token of ServiceAccount %s: %v", kogitoOperatorMetricsReaderName, err)
+	}
+	return tokenRequest.Status.Token, nil
+}
+
+func getKogitoOperatorMetricsReaderBindingName(namespace string) string {
+	return fmt.Sprintf("%s-%s", kogitoOperatorMetricsReaderName, namespace)
+}
+
+// forwardPodPort forwards a random local port to the pod port, returning the local port and a function stopping the forwarding
+func forwardPodPort(namespace, podName string, podPort int) (int, func(), error) {
+	restConfig, err := controllercliconfig.GetConfig()
+	if err != nil {
+		return 0, nil, fmt.Errorf("Error while reading Kubernetes connection config: %v", err)
+	}
+	transport, upgrader, err := spdy.RoundTripperFor(restConfig)
+	if err != nil {
+		return 0, nil, fmt.Errorf("Error while creating port forwarding transport: %v", err)
+	}
+	url := getKubeClient(namespace).KubernetesExtensionCli.CoreV1().RESTClient().Post().
+		Resource("pods").Namespace(namespace).Name(podName).SubResource("portforward").URL()
+	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, http.MethodPost, url)
+
+	stopChan, readyChan := make(chan struct{}), make(chan struct{})
+	forwarder, err := portforward.New(dialer, []string{fmt.Sprintf("0:%d", podPort)}, stopChan, readyChan, ioutil.Discard, ioutil.Discard)
+	if err != nil {
+		return 0, nil, fmt.Errorf("Error while creating port forwarding to pod %s: %v", podName, err)
+	}
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- forwarder.ForwardPorts()
+	}()
+
+	select {
+	case err := <-errChan:
+		return 0, nil, fmt.Errorf("Error while forwarding port %d of pod %s: %v", podPort, podName, err)
+	case <-readyChan:
+	}
+	ports, err := forwarder.GetPorts()
+	if err != nil {
+		close(stopChan)
+		return 0, nil, fmt.Errorf("Error while retrieving forwarded port of pod %s: %v", podName, err)
+	}
+	return int(ports[0].Local), func() { close(stopChan) }, nil
+}
+
+// parsePrometheusMetrics parses metrics in Prometheus text format, comments and empty lines are ignored
+func parsePrometheusMetrics(content string) (map[string]float64, error) {
+	metrics := map[string]float64{}
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		// Labels can contain spaces, so the series ends with the closing brace if any
+		seriesEnd := strings.LastIndex(line, "}") + 1
+		if seriesEnd == 0 {
+			seriesEnd = strings.IndexAny(line, " \t")
+		}
+		if seriesEnd <= 0 {
+			return nil, fmt.Errorf("Invalid metric line: %s", line)
+		}
+
+		// Sample can be followed by an optional timestamp
+		sample := strings.Fields(line[seriesEnd:])
+		if len(sample) == 0 {
+			return nil, fmt.Errorf("Missing value for metric line: %s", line)
+		}
+		value, err := strconv.ParseFloat(sample[0], 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for metric line %s: %v", line, err)
+		}
+		metrics[line[:seriesEnd]] = value
+	}
+	return metrics, scanner.Err()
+}
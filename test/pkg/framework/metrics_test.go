@@ -0,0 +1,53 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_parsePrometheusMetrics(t *testing.T) {
+	content := `# HELP controller_runtime_reconcile_total Total number of reconciliations per controller
+# TYPE controller_runtime_reconcile_total counter
+controller_runtime_reconcile_total{controller="kogitoruntime",result="error"} 2
+controller_runtime_reconcile_total{controller="kogitoruntime",result="success"} 15
+
+# HELP go_goroutines Number of goroutines that currently exist.
+# TYPE go_goroutines gauge
+go_goroutines 87
+process_start_time_seconds 1.61340962271e+09 1613409622710
+workqueue_longest_running_processor_seconds{name="kogito runtime"} +Inf
+`
+	metrics, err := parsePrometheusMetrics(content)
+	assert.NoError(t, err)
+	assert.Len(t, metrics, 5)
+	assert.Equal(t, float64(2), metrics[`controller_runtime_reconcile_total{controller="kogitoruntime",result="error"}`])
+	assert.Equal(t, float64(15), metrics[`controller_runtime_reconcile_total{controller="kogitoruntime",result="success"}`])
+	assert.Equal(t, float64(87), metrics["go_goroutines"])
+	assert.Equal(t, 1.61340962271e+09, metrics["process_start_time_seconds"])
+	assert.True(t, metrics[`workqueue_longest_running_processor_seconds{name="kogito runtime"}`] > 0)
+}
+
+func Test_parsePrometheusMetrics_InvalidValue(t *testing.T) {
+	_, err := parsePrometheusMetrics("go_goroutines abc")
+	assert.Error(t, err)
+}
+
+func Test_parsePrometheusMetrics_MissingValue(t *testing.T) {
+	_, err := parsePrometheusMetrics("go_goroutines")
+	assert.Error(t, err)
+}
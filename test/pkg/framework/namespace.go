@@ -15,6 +15,8 @@
 package framework
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -24,16 +26,24 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	controllercli "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const (
 	namespaceLogFile = "logs/namespace_history.log"
+
+	// NamespaceDeletionTimeoutInMin is the time given to a deleted namespace to be terminated
+	NamespaceDeletionTimeoutInMin = 10
+
+	// resourceQuotaUsageThresholdPercent usage of a quota resource considered too close to its hard limit to run a scenario
+	resourceQuotaUsageThresholdPercent = 90
 )
 
 // CreateNamespace creates a new namespace
 func CreateNamespace(namespace string) error {
 	GetLogger(namespace).Info("Creating namespace", "namespace", namespace)
-	_, err := kubernetes.NamespaceC(kubeClient).Create(namespace)
+	_, err := kubernetes.NamespaceC(getKubeClient(namespace)).Create(namespace)
 	if err != nil {
 		return fmt.Errorf("Cannot create namespace %s: %v", namespace, err)
 	}
@@ -44,7 +54,7 @@ func CreateNamespace(namespace string) error {
 // CreateNamespaceIfNotExists creates a new namespace if not exists, returns true if namespaces already existed
 func CreateNamespaceIfNotExists(namespace string) (exists bool, err error) {
 	GetLogger(namespace).Info("Creating namespace", "namespace", namespace)
-	_, err = kubernetes.NamespaceC(kubeClient).Create(namespace)
+	_, err = kubernetes.NamespaceC(getKubeClient(namespace)).Create(namespace)
 	if err != nil {
 		if errors.IsAlreadyExists(err) {
 			return true, nil
@@ -55,11 +65,47 @@ func CreateNamespaceIfNotExists(namespace string) (exists bool, err error) {
 	return false, nil
 }
 
+// EnsureNamespaceExists creates a new namespace, succeeding if the namespace already exists (e.g. from an interrupted run)
+func EnsureNamespaceExists(namespace string) error {
+	exists, err := CreateNamespaceIfNotExists(namespace)
+	if err != nil {
+		return err
+	} else if exists {
+		GetLogger(namespace).Info("Namespace already exists, reusing it", "namespace", namespace)
+	}
+	return nil
+}
+
+// CheckNamespaceResourceQuotaCompliance returns an error if any resource quota of the namespace is already used near its hard limit
+func CheckNamespaceResourceQuotaCompliance(namespace string) error {
+	resourceQuotas := &corev1.ResourceQuotaList{}
+	if err := kubernetes.ResourceC(getKubeClient(namespace)).ListWithNamespace(namespace, resourceQuotas); err != nil {
+		return fmt.Errorf("Error while listing resource quotas in namespace %s: %v", namespace, err)
+	}
+
+	var exceededResources []string
+	for _, resourceQuota := range resourceQuotas.Items {
+		for resourceName, hard := range resourceQuota.Status.Hard {
+			used, found := resourceQuota.Status.Used[resourceName]
+			if !found {
+				continue
+			}
+			if used.MilliValue()*100 >= hard.MilliValue()*resourceQuotaUsageThresholdPercent {
+				exceededResources = append(exceededResources, fmt.Sprintf("%s/%s (used %s of %s)", resourceQuota.Name, resourceName, used.String(), hard.String()))
+			}
+		}
+	}
+	if len(exceededResources) > 0 {
+		return fmt.Errorf("Namespace %s is near its resource quota limits: %s", namespace, strings.Join(exceededResources, ", "))
+	}
+	return nil
+}
+
 // DeleteNamespace deletes a namespace
 func DeleteNamespace(namespace string) error {
 	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace}}
 	GetLogger(namespace).Info("Deleting namespace", "namespace", namespace)
-	err := kubernetes.ResourceC(kubeClient).Delete(ns)
+	err := kubernetes.ResourceC(getKubeClient(namespace)).Delete(ns)
 	if err != nil {
 		return fmt.Errorf("Cannot delete namespace %s: %v", namespace, err)
 	}
@@ -67,9 +113,120 @@ func DeleteNamespace(namespace string) error {
 	return nil
 }
 
+// CleanupBDDNamespaces deletes all namespaces starting with the prefix and waits for them to be terminated
+func CleanupBDDNamespaces(prefix string) error {
+	if len(prefix) == 0 {
+		return fmt.Errorf("Namespace prefix must be provided to avoid deleting all namespaces")
+	}
+
+	namespaces := &corev1.NamespaceList{}
+	if err := kubernetes.ResourceC(kubeClient).ListWithNamespace(metav1.NamespaceAll, namespaces); err != nil {
+		return fmt.Errorf("Error while listing namespaces: %v", err)
+	}
+
+	var deletedNamespaces []string
+	for _, namespace := range namespaces.Items {
+		if !strings.HasPrefix(namespace.Name, prefix) {
+			continue
+		}
+		// Namespaces already terminating only need to be waited for
+		if namespace.Status.Phase != corev1.NamespaceTerminating {
+			if err := DeleteNamespace(namespace.Name); err != nil {
+				return err
+			}
+		}
+		deletedNamespaces = append(deletedNamespaces, namespace.Name)
+	}
+
+	for _, namespace := range deletedNamespaces {
+		if err := WaitForNamespaceTerminated(namespace, NamespaceDeletionTimeoutInMin); err != nil {
+			return err
+		}
+	}
+	GetMainLogger().Info("Namespaces cleaned up", "prefix", prefix, "count", len(deletedNamespaces))
+	return nil
+}
+
+// WaitForNamespaceTerminated waits for the namespace to be fully terminated and gone
+func WaitForNamespaceTerminated(namespace string, timeoutInMin int) error {
+	// Namespace logger may be already flushed, waiting is logged in main logger
+	return WaitForOnOpenshift(mainLoggerName, fmt.Sprintf("Namespace %s terminated", namespace), timeoutInMin,
+		func() (bool, error) {
+			exists, err := IsNamespace(namespace)
+			return !exists, err
+		})
+}
+
+// LabelNamespace adds the labels to the namespace, overwriting values of existing labels with the same keys
+func LabelNamespace(namespace string, labels map[string]string) error {
+	GetLogger(namespace).Info("Labeling namespace", "namespace", namespace, "labels", labels)
+	labelsPatch := make(map[string]interface{}, len(labels))
+	for key, value := range labels {
+		labelsPatch[key] = value
+	}
+	return patchNamespaceLabels(namespace, labelsPatch)
+}
+
+// UnlabelNamespace removes the labels with given keys from the namespace
+func UnlabelNamespace(namespace string, keys []string) error {
+	GetLogger(namespace).Info("Removing labels from namespace", "namespace", namespace, "keys", keys)
+	labelsPatch := make(map[string]interface{}, len(keys))
+	for _, key := range keys {
+		// Null value removes the label in JSON merge patch
+		labelsPatch[key] = nil
+	}
+	return patchNamespaceLabels(namespace, labelsPatch)
+}
+
+func patchNamespaceLabels(namespace string, labelsPatch map[string]interface{}) error {
+	ns, err := kubernetes.NamespaceC(getKubeClient(namespace)).Fetch(namespace)
+	if err != nil {
+		return fmt.Errorf("Error while fetching namespace %s: %v", namespace, err)
+	} else if ns == nil {
+		return fmt.Errorf("Namespace %s doesn't exist", namespace)
+	}
+
+	patch, err := json.Marshal(map[string]interface{}{"metadata": map[string]interface{}{"labels": labelsPatch}})
+	if err != nil {
+		return fmt.Errorf("Error while creating labels patch of namespace %s: %v", namespace, err)
+	}
+	if err := getKubeClient(namespace).ControlCli.Patch(context.TODO(), ns, controllercli.RawPatch(types.MergePatchType, patch)); err != nil {
+		return fmt.Errorf("Error while patching labels of namespace %s: %v", namespace, err)
+	}
+	return nil
+}
+
+// FetchNamespaceLabels returns the labels of the namespace
+func FetchNamespaceLabels(namespace string) (map[string]string, error) {
+	ns, err := fetchExistingNamespace(namespace)
+	if err != nil {
+		return nil, err
+	}
+	return ns.Labels, nil
+}
+
+// FetchNamespaceAnnotations returns the annotations of the namespace
+func FetchNamespaceAnnotations(namespace string) (map[string]string, error) {
+	ns, err := fetchExistingNamespace(namespace)
+	if err != nil {
+		return nil, err
+	}
+	return ns.Annotations, nil
+}
+
+func fetchExistingNamespace(namespace string) (*corev1.Namespace, error) {
+	ns, err := kubernetes.NamespaceC(getKubeClient(namespace)).Fetch(namespace)
+	if err != nil {
+		return nil, fmt.Errorf("Error while fetching namespace %s: %v", namespace, err)
+	} else if ns == nil {
+		return nil, fmt.Errorf("Namespace %s doesn't exist", namespace)
+	}
+	return ns, nil
+}
+
 // IsNamespace checks whether a namespace exists
 func IsNamespace(namespace string) (bool, error) {
-	ns, err := kubernetes.NamespaceC(kubeClient).Fetch(namespace)
+	ns, err := kubernetes.NamespaceC(getKubeClient(namespace)).Fetch(namespace)
 	if err != nil {
 		return false, fmt.Errorf("Cannot checking namespace %s: %v", namespace, err)
 	}
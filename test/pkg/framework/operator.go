@@ -15,15 +15,27 @@
 package framework
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/kiegroup/kogito-operator/core/infrastructure"
 	"github.com/kiegroup/kogito-operator/core/logger"
 	"github.com/kiegroup/kogito-operator/meta"
 
 	v1 "k8s.io/api/apps/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+	controllercli "sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/kiegroup/kogito-operator/core/client/kubernetes"
 	"github.com/kiegroup/kogito-operator/core/framework"
@@ -32,10 +44,12 @@ import (
 
 	olmapiv1 "github.com/operator-framework/operator-lifecycle-manager/pkg/api/apis/operators/v1"
 	olmapiv1alpha1 "github.com/operator-framework/operator-lifecycle-manager/pkg/api/apis/operators/v1alpha1"
+	olmpackagev1 "github.com/operator-framework/operator-lifecycle-manager/pkg/package-server/apis/operators/v1"
 )
 
 const (
 	kogitoOperatorTimeoutInMin     = 5
+	kogitoOperatorMaxRestarts      = 3
 	kogitoInfinispanDependencyName = "Infinispan"
 	kogitoKafkaDependencyName      = "Kafka"
 	kogitoKeycloakDependencyName   = "Keycloak"
@@ -44,11 +58,32 @@ const (
 	clusterWideSubscriptionLabel = "kogito-operator-bdd-tests"
 
 	kogitoOperatorDeploymentName = "kogito-operator-controller-manager"
+	kogitoOperatorPackageName    = "kogito-operator"
+	kogitoOperatorContainerName  = "manager"
+	// kogitoOperatorPodLabelKey and kogitoOperatorPodLabelValue identify Kogito operator pods, as set in the manager Deployment pod template
+	kogitoOperatorPodLabelKey   = "control-plane"
+	kogitoOperatorPodLabelValue = "controller-manager"
+	// kogitoOperatorWatchNamespaceEnvVar env variable defining namespaces watched by Kogito operator, as read in main.go
+	kogitoOperatorWatchNamespaceEnvVar = "WATCH_NAMESPACE"
+	// kogitoOperatorLeaderElectionID name of the lock used by Kogito operator replicas for leader election, as set in main.go
+	kogitoOperatorLeaderElectionID = "4662f1d5.kiegroup.org"
+	// kogitoOperatorConfigMapName name of the ConfigMap used to configure Kogito operator
+	kogitoOperatorConfigMapName = "kogito-operator-config"
+	// kogitoOperatorConfigUpdatedAnnotation pod template annotation changed to restart Kogito operator pods on configuration update
+	kogitoOperatorConfigUpdatedAnnotation = "kogito.kie.org/config-updated-at"
 
 	// kogitoCatalogSourceName name of the CatalogSource containing Kogito bundle for BDD tests
 	kogitoCatalogSourceName = "bdd-tests-kogito-catalog"
 
 	openShiftMarketplaceNamespace = "openshift-marketplace"
+
+	// catalogSourceReadyState GRPC connection state of a CatalogSource which can be used
+	catalogSourceReadyState = "READY"
+	// catalogSourceGRPCPort port of the registry server serving CatalogSource content
+	catalogSourceGRPCPort = 50051
+
+	// operatorLabelPrefix prefix of the label set by OLM on operator resources, in the form operators.coreos.com/<package>.<namespace>
+	operatorLabelPrefix = "operators.coreos.com/"
 )
 
 // OperatorCatalog OLM operator catalog
@@ -57,9 +92,33 @@ type OperatorCatalog struct {
 	namespace string
 }
 
+// CatalogSourceOptions contains optional configuration of an OLM CatalogSource
+type CatalogSourceOptions struct {
+	// UpdateStrategy defines how often OLM checks the catalog image for updates, OLM default is used if nil
+	UpdateStrategy *olmapiv1alpha1.UpdateStrategy
+}
+
+// NewRegistryPollUpdateStrategy creates a CatalogSource update strategy re-checking the catalog image at the given interval
+func NewRegistryPollUpdateStrategy(interval time.Duration) *olmapiv1alpha1.UpdateStrategy {
+	return &olmapiv1alpha1.UpdateStrategy{
+		RegistryPoll: &olmapiv1alpha1.RegistryPoll{
+			Interval: &metav1.Duration{Duration: interval},
+		},
+	}
+}
+
+// SubscriptionOptions contains optional configuration of an OLM Subscription
+type SubscriptionOptions struct {
+	// StartingCSV pins the initially installed version of the operator, ignored if empty
+	StartingCSV string
+}
+
 var (
 	kogitoOperatorPullImageSecretPrefix = operator.Name + "-dockercfg"
 
+	// csvVersionRegex matches semver suffix of CSV names in the form <package>.v<version>
+	csvVersionRegex = regexp.MustCompile(`\.v(\d+\.\d+\.\d+(?:[-+][0-9A-Za-z.+-]+)?)$`)
+
 	// KogitoOperatorDependencies contains list of operators to be used together with Kogito operator
 	KogitoOperatorDependencies = []string{kogitoInfinispanDependencyName, kogitoKafkaDependencyName, kogitoKeycloakDependencyName}
 
@@ -67,6 +126,16 @@ var (
 	KogitoOperatorMongoDBDependency = infrastructure.MongoDBKind
 	mongoDBOperatorTimeoutInMin     = 10
 
+	infinispanOperatorTimeoutInMin = 10
+	// infinispanOperatorDeploymentNames Infinispan operator Deployment names, latest first followed by the one used by 2.0.x versions
+	infinispanOperatorDeploymentNames = []string{"infinispan-operator-controller-manager", "infinispan-operator"}
+
+	keycloakOperatorTimeoutInMin   = 10
+	keycloakOperatorDeploymentName = "keycloak-operator"
+
+	// kafkaOperatorPackageName Strimzi operator package, installed cluster wide
+	kafkaOperatorPackageName = "strimzi-kafka-operator"
+
 	kogitoOperatorCatalogSourceTimeoutInMin = 3
 
 	// CommunityCatalog operator catalog for community
@@ -86,6 +155,24 @@ var (
 	}
 )
 
+// NewOperatorCatalog creates a reference to an OLM operator catalog based on CatalogSource name and namespace
+func NewOperatorCatalog(source, namespace string) OperatorCatalog {
+	return OperatorCatalog{
+		source:    source,
+		namespace: namespace,
+	}
+}
+
+// GetOperatorCatalog returns the operator catalog with the given CatalogSource name, catalogs not known by the framework are expected in the marketplace namespace
+func GetOperatorCatalog(source string) OperatorCatalog {
+	for _, catalog := range []OperatorCatalog{CommunityCatalog, OperatorHubCatalog, CustomKogitoOperatorCatalog} {
+		if catalog.source == source {
+			return catalog
+		}
+	}
+	return NewOperatorCatalog(source, openShiftMarketplaceNamespace)
+}
+
 // IsKogitoOperatorRunning returns whether Kogito operator is running
 func IsKogitoOperatorRunning(namespace string) (bool, error) {
 	exists, err := KogitoOperatorExists(namespace)
@@ -103,28 +190,114 @@ func IsKogitoOperatorRunning(namespace string) (bool, error) {
 func KogitoOperatorExists(namespace string) (bool, error) {
 	GetLogger(namespace).Debug("Checking Operator", "Deployment", kogitoOperatorDeploymentName, "Namespace", namespace)
 
-	operatorDeployment := &v1.Deployment{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      kogitoOperatorDeploymentName,
-			Namespace: namespace,
-		},
-	}
-	if exists, err := kubernetes.ResourceC(kubeClient).Fetch(operatorDeployment); err != nil {
-		return false, fmt.Errorf("Error while trying to look for Deploment %s: %v ", kogitoOperatorDeploymentName, err)
-	} else if !exists {
+	operatorDeployment, err := GetKogitoOperatorDeployment(namespace)
+	if err != nil {
+		return false, err
+	} else if operatorDeployment == nil {
 		return false, nil
 	}
 
-	if operatorDeployment.Status.AvailableReplicas == 0 {
-		return true, fmt.Errorf("%s Operator seems to be created in the namespace '%s', but there's no available pods replicas deployed ", operator.Name, namespace)
+	if !isDeploymentRolledOutToSpec(operatorDeployment) {
+		return true, fmt.Errorf("%s Operator seems to be created in the namespace '%s', but its pods replicas are not all rolled out ", operator.Name, namespace)
 	}
 
 	return true, nil
 }
 
+// GetKogitoOperatorDeployment returns the Kogito operator Deployment, nil if it doesn't exist
+func GetKogitoOperatorDeployment(namespace string) (*v1.Deployment, error) {
+	operatorDeployment, err := GetDeployment(namespace, kogitoOperatorDeploymentName)
+	if err != nil {
+		return nil, fmt.Errorf("Error while trying to look for Deploment %s: %v ", kogitoOperatorDeploymentName, err)
+	}
+	return operatorDeployment, nil
+}
+
+// GetKogitoOperatorResourceLimits returns the resource requests and limits of Kogito operator container
+func GetKogitoOperatorResourceLimits(namespace string) (*corev1.ResourceList, *corev1.ResourceList, error) {
+	operatorDeployment, err := GetKogitoOperatorDeployment(namespace)
+	if err != nil {
+		return nil, nil, err
+	} else if operatorDeployment == nil {
+		return nil, nil, fmt.Errorf("Kogito operator Deployment %s not found in namespace %s", kogitoOperatorDeploymentName, namespace)
+	}
+
+	containers := operatorDeployment.Spec.Template.Spec.Containers
+	if len(containers) == 0 {
+		return nil, nil, fmt.Errorf("Kogito operator Deployment %s doesn't define any container", kogitoOperatorDeploymentName)
+	}
+	// Operator container may be preceded by the auth proxy sidecar, first container is used as fallback
+	container := containers[0]
+	for _, c := range containers {
+		if c.Name == kogitoOperatorContainerName {
+			container = c
+			break
+		}
+	}
+	return &container.Resources.Requests, &container.Resources.Limits, nil
+}
+
+// GetKogitoOperatorServiceAccount returns the name of the ServiceAccount used by Kogito operator pods
+func GetKogitoOperatorServiceAccount(namespace string) (string, error) {
+	operatorDeployment, err := GetKogitoOperatorDeployment(namespace)
+	if err != nil {
+		return "", err
+	} else if operatorDeployment == nil {
+		return "", fmt.Errorf("Kogito operator Deployment %s not found in namespace %s", kogitoOperatorDeploymentName, namespace)
+	}
+	return operatorDeployment.Spec.Template.Spec.ServiceAccountName, nil
+}
+
+// VerifyKogitoOperatorWatchesNamespace returns whether Kogito operator watches the namespace, according to the comma separated namespaces of its WATCH_NAMESPACE env variable. Empty value means all namespaces are watched.
+func VerifyKogitoOperatorWatchesNamespace(operatorNamespace, watchedNamespace string) (bool, error) {
+	operatorDeployment, err := GetKogitoOperatorDeployment(operatorNamespace)
+	if err != nil {
+		return false, err
+	} else if operatorDeployment == nil {
+		return false, fmt.Errorf("Kogito operator Deployment %s not found in namespace %s", kogitoOperatorDeploymentName, operatorNamespace)
+	}
+
+	for _, container := range operatorDeployment.Spec.Template.Spec.Containers {
+		if container.Name != kogitoOperatorContainerName {
+			continue
+		}
+		watchNamespace := framework.GetEnvVarFromContainer(kogitoOperatorWatchNamespaceEnvVar, &container)
+		if len(strings.TrimSpace(watchNamespace)) == 0 {
+			return true, nil
+		}
+		for _, namespace := range strings.Split(watchNamespace, ",") {
+			if strings.TrimSpace(namespace) == watchedNamespace {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+	return false, fmt.Errorf("Kogito operator Deployment %s doesn't define container %s", kogitoOperatorDeploymentName, kogitoOperatorContainerName)
+}
+
+// GetKogitoOperatorPackageName returns the OLM package name of Kogito operator
+func GetKogitoOperatorPackageName() string {
+	return kogitoOperatorPackageName
+}
+
+// GetKogitoOperatorContainerName returns the name of the Kogito operator container
+func GetKogitoOperatorContainerName() string {
+	return kogitoOperatorContainerName
+}
+
+// GetKogitoOperatorPodLabels returns the labels selecting Kogito operator pods
+func GetKogitoOperatorPodLabels() map[string]string {
+	return map[string]string{kogitoOperatorPodLabelKey: kogitoOperatorPodLabelValue}
+}
+
 // WaitForKogitoOperatorRunning waits for Kogito operator running
 func WaitForKogitoOperatorRunning(namespace string) error {
-	return WaitForOnOpenshift(namespace, "Kogito operator running", kogitoOperatorTimeoutInMin,
+	return WaitForKogitoOperatorRunningWithOptions(namespace, DefaultWaitOptions())
+}
+
+// WaitForKogitoOperatorRunningWithOptions waits for Kogito operator running, polling as configured by the given options
+func WaitForKogitoOperatorRunningWithOptions(namespace string, options WaitOptions) error {
+	return WaitForOnOpenshiftWithOptions(namespace, "Kogito operator running", kogitoOperatorTimeoutInMin, options,
 		func() (bool, error) {
 			running, err := IsKogitoOperatorRunning(namespace)
 			if err != nil {
@@ -135,7 +308,7 @@ func WaitForKogitoOperatorRunning(namespace string) error {
 			// If not present, delete the pod to allow its reconstruction with correct pull secret
 			// Note that this is specific to Openshift
 			if !running && IsOpenshift() {
-				podList, err := GetPodsWithLabels(namespace, map[string]string{"name": operator.Name})
+				podList, err := GetPodsWithLabels(namespace, GetKogitoOperatorPodLabels())
 				if err != nil {
 					GetLogger(namespace).Error(err, "Error while trying to retrieve Kogito Operator pods")
 					return false, nil
@@ -144,7 +317,7 @@ func WaitForKogitoOperatorRunning(namespace string) error {
 					if !CheckPodHasImagePullSecretWithPrefix(&pod, kogitoOperatorPullImageSecretPrefix) {
 						// Delete pod as it has been misconfigured (missing pull secret)
 						GetLogger(namespace).Info("Kogito Operator pod does not have the image pull secret needed. Deleting it to renew it.")
-						err := kubernetes.ResourceC(kubeClient).Delete(&pod)
+						err := kubernetes.ResourceC(getKubeClient(namespace)).Delete(&pod)
 						if err != nil {
 							GetLogger(namespace).Error(err, "Error while trying to delete Kogito Operator pod")
 							return false, nil
@@ -153,28 +326,246 @@ func WaitForKogitoOperatorRunning(namespace string) error {
 				}
 			}
 			return running, nil
-		})
+		}, checkKogitoOperatorPodsCrashLooping(namespace))
+}
+
+// checkKogitoOperatorPodsCrashLooping returns a function that checks whether Kogito operator pods restarted too many times
+func checkKogitoOperatorPodsCrashLooping(namespace string) func() (bool, error) {
+	return func() (bool, error) {
+		withinLimit, err := CheckPodsRestartCount(namespace, GetKogitoOperatorPodLabels(), kogitoOperatorMaxRestarts)
+		return !withinLimit, err
+	}
+}
+
+// TailOperatorLogs streams the log of Kogito operator pod into the writer until the pod is terminated or the context is cancelled
+func TailOperatorLogs(ctx context.Context, namespace string, writer io.Writer) error {
+	pods, err := GetPodsByDeployment(namespace, kogitoOperatorDeploymentName)
+	if err != nil {
+		return fmt.Errorf("Error while trying to retrieve Kogito operator pods: %v", err)
+	} else if len(pods) == 0 {
+		return fmt.Errorf("No Kogito operator pod found in namespace %s", namespace)
+	}
+
+	podName := pods[0].GetName()
+	stream, err := kubernetes.PodC(getKubeClient(namespace)).StreamLogsWithFollow(ctx, namespace, podName, kogitoOperatorContainerName)
+	if err != nil {
+		return fmt.Errorf("Error while streaming log of Kogito operator pod %s: %v", podName, err)
+	}
+	defer stream.Close()
+
+	if _, err := io.Copy(writer, stream); err != nil && ctx.Err() == nil {
+		return fmt.Errorf("Error while writing log of Kogito operator pod %s: %v", podName, err)
+	}
+	return nil
+}
+
+// WaitForKogitoOperatorHealthy waits for Kogito operator running with all its pods ready
+func WaitForKogitoOperatorHealthy(namespace string, timeoutInMin int) error {
+	if err := WaitForOnOpenshift(namespace, "Kogito operator running", timeoutInMin,
+		func() (bool, error) {
+			return IsKogitoOperatorRunning(namespace)
+		}); err != nil {
+		return err
+	}
+	return WaitForPodReady(namespace, GetKogitoOperatorPodLabels(), timeoutInMin)
+}
+
+// GetOperatorPodNodeName returns the name of the node where the running Kogito operator pod is scheduled
+func GetOperatorPodNodeName(namespace string) (string, error) {
+	pods, err := GetPodsByDeployment(namespace, kogitoOperatorDeploymentName)
+	if err != nil {
+		return "", err
+	}
+	for _, pod := range pods {
+		if pod.Status.Phase == corev1.PodRunning {
+			return pod.Spec.NodeName, nil
+		}
+	}
+	return "", fmt.Errorf("No running pod of Kogito operator Deployment %s found in namespace %s", kogitoOperatorDeploymentName, namespace)
+}
+
+// CheckKogitoOperatorLeaderElection returns the identity of the Kogito operator replica holding the leader election lock
+func CheckKogitoOperatorLeaderElection(namespace string) (string, error) {
+	key := types.NamespacedName{Namespace: namespace, Name: kogitoOperatorLeaderElectionID}
+
+	lease := &coordinationv1.Lease{}
+	if exists, err := kubernetes.ResourceC(getKubeClient(namespace)).FetchWithKey(key, lease); err != nil {
+		return "", fmt.Errorf("Error while trying to look for leader election Lease %s: %v", kogitoOperatorLeaderElectionID, err)
+	} else if exists {
+		if lease.Spec.HolderIdentity == nil || len(*lease.Spec.HolderIdentity) == 0 {
+			return "", fmt.Errorf("Leader election Lease %s in namespace %s doesn't have any holder", kogitoOperatorLeaderElectionID, namespace)
+		}
+		return *lease.Spec.HolderIdentity, nil
+	}
+
+	// Operator built with controller-runtime 0.6 uses ConfigMap lock, holder is stored in annotation
+	configMap := &corev1.ConfigMap{}
+	if exists, err := kubernetes.ResourceC(getKubeClient(namespace)).FetchWithKey(key, configMap); err != nil {
+		return "", fmt.Errorf("Error while trying to look for leader election ConfigMap %s: %v", kogitoOperatorLeaderElectionID, err)
+	} else if !exists {
+		return "", fmt.Errorf("No leader election lock %s found in namespace %s", kogitoOperatorLeaderElectionID, namespace)
+	}
+	leaderRecord := &resourcelock.LeaderElectionRecord{}
+	if err := json.Unmarshal([]byte(configMap.Annotations[resourcelock.LeaderElectionRecordAnnotationKey]), leaderRecord); err != nil {
+		return "", fmt.Errorf("Error while parsing leader election record of ConfigMap %s: %v", kogitoOperatorLeaderElectionID, err)
+	} else if len(leaderRecord.HolderIdentity) == 0 {
+		return "", fmt.Errorf("Leader election ConfigMap %s in namespace %s doesn't have any holder", kogitoOperatorLeaderElectionID, namespace)
+	}
+	return leaderRecord.HolderIdentity, nil
+}
+
+// AnnotateKogitoOperatorDeployment merges the given annotations into Kogito operator Deployment and waits for its rollout
+func AnnotateKogitoOperatorDeployment(namespace string, annotations map[string]string) error {
+	GetLogger(namespace).Info("Annotating Kogito operator Deployment", "annotations", annotations)
+	return updateKogitoOperatorDeploymentAnnotations(namespace, func(deploymentAnnotations map[string]string) {
+		for key, value := range annotations {
+			deploymentAnnotations[key] = value
+		}
+	})
+}
+
+// RemoveKogitoOperatorDeploymentAnnotations removes the annotations with given keys from Kogito operator Deployment and waits for its rollout
+func RemoveKogitoOperatorDeploymentAnnotations(namespace string, keys []string) error {
+	GetLogger(namespace).Info("Removing annotations from Kogito operator Deployment", "keys", keys)
+	return updateKogitoOperatorDeploymentAnnotations(namespace, func(deploymentAnnotations map[string]string) {
+		for _, key := range keys {
+			delete(deploymentAnnotations, key)
+		}
+	})
+}
+
+func updateKogitoOperatorDeploymentAnnotations(namespace string, updateAnnotations func(deploymentAnnotations map[string]string)) error {
+	deployment, err := GetKogitoOperatorDeployment(namespace)
+	if err != nil {
+		return err
+	} else if deployment == nil {
+		return fmt.Errorf("Deployment %s not found in namespace %s", kogitoOperatorDeploymentName, namespace)
+	}
+
+	if deployment.Annotations == nil {
+		deployment.Annotations = map[string]string{}
+	}
+	updateAnnotations(deployment.Annotations)
+	if err := kubernetes.ResourceC(getKubeClient(namespace)).Update(deployment); err != nil {
+		return fmt.Errorf("Error while updating annotations of Deployment %s: %v ", kogitoOperatorDeploymentName, err)
+	}
+
+	replicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+	return WaitForDeploymentRollout(namespace, kogitoOperatorDeploymentName, replicas, kogitoOperatorTimeoutInMin)
+}
+
+// FetchKogitoOperatorConfigMap returns the ConfigMap configuring Kogito operator, nil if it doesn't exist
+func FetchKogitoOperatorConfigMap(namespace string) (*corev1.ConfigMap, error) {
+	configMap := &corev1.ConfigMap{}
+	if exists, err := kubernetes.ResourceC(getKubeClient(namespace)).FetchWithKey(types.NamespacedName{Name: kogitoOperatorConfigMapName, Namespace: namespace}, configMap); err != nil {
+		return nil, fmt.Errorf("Error while trying to look for ConfigMap %s: %v ", kogitoOperatorConfigMapName, err)
+	} else if !exists {
+		return nil, nil
+	}
+	return configMap, nil
+}
+
+// UpdateKogitoOperatorConfigMap creates or patches the ConfigMap configuring Kogito operator with given data and waits for the operator rollout
+func UpdateKogitoOperatorConfigMap(namespace string, data map[string]string) error {
+	GetLogger(namespace).Info("Updating Kogito operator ConfigMap", "name", kogitoOperatorConfigMapName, "data", data)
+	configMap, err := FetchKogitoOperatorConfigMap(namespace)
+	if err != nil {
+		return err
+	}
+
+	if configMap == nil {
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: kogitoOperatorConfigMapName, Namespace: namespace},
+			Data:       data,
+		}
+		if err := kubernetes.ResourceC(getKubeClient(namespace)).Create(configMap); err != nil {
+			return fmt.Errorf("Error while creating ConfigMap %s: %v", kogitoOperatorConfigMapName, err)
+		}
+	} else {
+		patch, err := json.Marshal(map[string]interface{}{"data": data})
+		if err != nil {
+			return fmt.Errorf("Error while creating data patch of ConfigMap %s: %v", kogitoOperatorConfigMapName, err)
+		}
+		if err := getKubeClient(namespace).ControlCli.Patch(context.TODO(), configMap, controllercli.RawPatch(types.MergePatchType, patch)); err != nil {
+			return fmt.Errorf("Error while patching ConfigMap %s: %v", kogitoOperatorConfigMapName, err)
+		}
+	}
+
+	return restartKogitoOperatorDeployment(namespace)
+}
+
+// restartKogitoOperatorDeployment triggers a rollout of Kogito operator Deployment by changing its pod template and waits for it
+func restartKogitoOperatorDeployment(namespace string) error {
+	deployment, err := GetKogitoOperatorDeployment(namespace)
+	if err != nil {
+		return err
+	} else if deployment == nil {
+		return fmt.Errorf("Deployment %s not found in namespace %s", kogitoOperatorDeploymentName, namespace)
+	}
+
+	if deployment.Spec.Template.Annotations == nil {
+		deployment.Spec.Template.Annotations = map[string]string{}
+	}
+	deployment.Spec.Template.Annotations[kogitoOperatorConfigUpdatedAnnotation] = time.Now().Format(time.RFC3339)
+	if err := kubernetes.ResourceC(getKubeClient(namespace)).Update(deployment); err != nil {
+		return fmt.Errorf("Error while restarting Deployment %s: %v ", kogitoOperatorDeploymentName, err)
+	}
+
+	replicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+	return WaitForDeploymentRollout(namespace, kogitoOperatorDeploymentName, replicas, kogitoOperatorTimeoutInMin)
 }
 
 // InstallOperator installs an operator via subscrition
 func InstallOperator(namespace, subscriptionName, channel string, catalog OperatorCatalog) error {
-	GetLogger(namespace).Info("Subscribing to operator", "subscriptionName", subscriptionName, "catalogSource", catalog.source, "channel", channel)
+	return InstallOperatorWithOptions(namespace, subscriptionName, channel, catalog, SubscriptionOptions{})
+}
+
+// InstallOperatorWithOptions installs an operator via subscrition created with the given options
+func InstallOperatorWithOptions(namespace, subscriptionName, channel string, catalog OperatorCatalog, options SubscriptionOptions) error {
+	GetLogger(namespace).Info("Subscribing to operator", "subscriptionName", subscriptionName, "catalogSource", catalog.source, "channel", channel, "startingCSV", options.StartingCSV)
+	if err := CheckOperatorGroupSingletonConstraint(namespace); err != nil {
+		return err
+	}
 	if _, err := CreateOperatorGroupIfNotExists(namespace, namespace); err != nil {
 		return err
 	}
 
-	if _, err := CreateNamespacedSubscriptionIfNotExist(namespace, subscriptionName, subscriptionName, catalog, channel); err != nil {
+	if _, err := CreateNamespacedSubscriptionIfNotExist(namespace, subscriptionName, subscriptionName, catalog, channel, options); err != nil {
 		return err
 	}
 
 	return nil
 }
 
+// InstallOperatorWithFallback installs an operator via subscription from the first catalog which is healthy
+func InstallOperatorWithFallback(namespace, subscriptionName, channel string, catalogs ...OperatorCatalog) error {
+	if len(catalogs) == 0 {
+		return fmt.Errorf("No catalog provided to install operator %s", subscriptionName)
+	}
+
+	var catalogErrors []string
+	for _, catalog := range catalogs {
+		if err := CheckSubscriptionCatalogSourceHealthy(namespace, catalog); err != nil {
+			GetLogger(namespace).Warn("Catalog not available, trying next one", "subscriptionName", subscriptionName, "catalogSource", catalog.source, "error", err)
+			catalogErrors = append(catalogErrors, err.Error())
+			continue
+		}
+		return InstallOperator(namespace, subscriptionName, channel, catalog)
+	}
+	return fmt.Errorf("No healthy catalog found to install operator %s: %s", subscriptionName, strings.Join(catalogErrors, "; "))
+}
+
 // InstallClusterWideOperator installs an operator for all namespaces via subscrition
 func InstallClusterWideOperator(subscriptionName, channel string, catalog OperatorCatalog) error {
 	olmNamespace := config.GetOlmNamespace()
 	GetLogger(olmNamespace).Info("Subscribing to operator", "subscriptionName", subscriptionName, "catalogSource", catalog.source, "channel", channel, "namespace", olmNamespace)
-	if _, err := CreateNamespacedSubscriptionIfNotExist(olmNamespace, subscriptionName, subscriptionName, catalog, channel); err != nil {
+	if _, err := CreateNamespacedSubscriptionIfNotExist(olmNamespace, subscriptionName, subscriptionName, catalog, channel, SubscriptionOptions{}); err != nil {
 		return err
 	}
 
@@ -183,10 +574,58 @@ func InstallClusterWideOperator(subscriptionName, channel string, catalog Operat
 
 // WaitForOperatorRunning waits for an operator to be running
 func WaitForOperatorRunning(namespace, operatorPackageName string, catalog OperatorCatalog, timeoutInMin int) error {
-	return WaitForOnOpenshift(namespace, fmt.Sprintf("%s operator running", operatorPackageName), timeoutInMin,
+	err := WaitForOnOpenshift(namespace, fmt.Sprintf("%s operator running", operatorPackageName), timeoutInMin,
 		func() (bool, error) {
-			return IsOperatorRunning(namespace, operatorPackageName, catalog)
+			if running, err := IsOperatorRunning(namespace, operatorPackageName, catalog); err != nil || !running {
+				return false, err
+			}
+			// OLM can register CRDs asynchronously, operator CRs cannot be created until they are served
+			return areOperatorOwnedCRDsAvailable(namespace, operatorPackageName, catalog)
 		})
+	if err != nil {
+		// Subscriptions created by the framework are named after the operator package
+		if installPlanRef, refErr := GetSubscriptionInstallPlanRef(namespace, operatorPackageName); refErr == nil && installPlanRef != nil {
+			return fmt.Errorf("%v (install plan: %s/%s)", err, installPlanRef.Namespace, installPlanRef.Name)
+		}
+	}
+	return err
+}
+
+// areOperatorOwnedCRDsAvailable checks whether all CRDs owned by the current CSV of the operator subscription are available
+func areOperatorOwnedCRDsAvailable(namespace, operatorPackageName string, catalog OperatorCatalog) (bool, error) {
+	subscription, err := GetSubscription(namespace, operatorPackageName, catalog)
+	if err != nil {
+		return false, err
+	}
+	currentCsv := subscription.Status.CurrentCSV
+	if len(currentCsv) == 0 {
+		return false, nil
+	}
+
+	csv := &olmapiv1alpha1.ClusterServiceVersion{}
+	if exists, err := kubernetes.ResourceC(getKubeClient(namespace)).FetchWithKey(types.NamespacedName{Namespace: namespace, Name: currentCsv}, csv); err != nil {
+		return false, fmt.Errorf("Error while trying to fetch ClusterServiceVersion %s: %v", currentCsv, err)
+	} else if !exists {
+		return false, nil
+	}
+
+	for _, ownedCRD := range csv.Spec.CustomResourceDefinitions.Owned {
+		if available, err := isCRDAvailable(ownedCRD.Name); err != nil || !available {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// GetSubscriptionInstallPlanRef returns the reference to the install plan of the subscription, nil if no install plan was created yet
+func GetSubscriptionInstallPlanRef(namespace, subscriptionName string) (*corev1.ObjectReference, error) {
+	subscription := &olmapiv1alpha1.Subscription{}
+	if exists, err := kubernetes.ResourceC(getKubeClient(namespace)).FetchWithKey(types.NamespacedName{Namespace: namespace, Name: subscriptionName}, subscription); err != nil {
+		return nil, fmt.Errorf("Error while trying to look for Subscription %s: %v", subscriptionName, err)
+	} else if !exists {
+		return nil, fmt.Errorf("Subscription %s not found in namespace %s", subscriptionName, namespace)
+	}
+	return subscription.Status.InstallPlanRef, nil
 }
 
 // WaitForClusterWideOperatorRunning waits for a cluster wide operator to be running
@@ -212,7 +651,7 @@ func IsOperatorRunning(namespace, operatorPackageName string, catalog OperatorCa
 func OperatorExistsUsingSubscription(namespace, operatorPackageName, operatorSource string) (bool, error) {
 	GetLogger(namespace).Debug("Checking Operator", "Subscription", operatorPackageName, "Namespace", namespace)
 
-	subscription, err := framework.GetSubscription(kubeClient, namespace, operatorPackageName, operatorSource)
+	subscription, err := framework.GetSubscription(getKubeClient(namespace), namespace, operatorPackageName, operatorSource)
 	if err != nil {
 		return false, err
 	} else if subscription == nil {
@@ -229,7 +668,7 @@ func OperatorExistsUsingSubscription(namespace, operatorPackageName, operatorSou
 	GetLogger(namespace).Debug("Found current CSV in", "Subscription", subscriptionCsv)
 
 	operatorDeployments := &v1.DeploymentList{}
-	if err := kubernetes.ResourceC(kubeClient).ListWithNamespaceAndLabel(namespace, operatorDeployments, map[string]string{"olm.owner.kind": "ClusterServiceVersion", "olm.owner": subscriptionCsv}); err != nil {
+	if err := kubernetes.ResourceC(getKubeClient(namespace)).ListWithNamespaceAndLabel(namespace, operatorDeployments, map[string]string{"olm.owner.kind": "ClusterServiceVersion", "olm.owner": subscriptionCsv}); err != nil {
 		return false, fmt.Errorf("Error while trying to fetch DC with label olm.owner: '%s' Operator installation: %s ", subscriptionCsv, err)
 	}
 
@@ -252,15 +691,90 @@ func CreateOperatorGroupIfNotExists(namespace, operatorGroupName string) (*olmap
 			TargetNamespaces: []string{namespace},
 		},
 	}
-	if err := kubernetes.ResourceC(kubeClient).CreateIfNotExists(operatorGroup); err != nil {
+	if err := kubernetes.ResourceC(getKubeClient(namespace)).CreateIfNotExists(operatorGroup); err != nil {
 		return nil, fmt.Errorf("Error creating OperatorGroup %s: %v", operatorGroupName, err)
 	}
 	return operatorGroup, nil
 }
 
+// ListOperatorGroupsInNamespace returns all operator groups in the namespace
+func ListOperatorGroupsInNamespace(namespace string) (*olmapiv1.OperatorGroupList, error) {
+	operatorGroups := &olmapiv1.OperatorGroupList{}
+	if err := kubernetes.ResourceC(getKubeClient(namespace)).ListWithNamespace(namespace, operatorGroups); err != nil {
+		return nil, fmt.Errorf("Error retrieving OperatorGroupList in namespace %s: %v", namespace, err)
+	}
+	return operatorGroups, nil
+}
+
+// CheckOperatorGroupSingletonConstraint returns an error if more than one operator group exists in the namespace, OLM fails to install operators in such namespace
+func CheckOperatorGroupSingletonConstraint(namespace string) error {
+	operatorGroups, err := ListOperatorGroupsInNamespace(namespace)
+	if err != nil {
+		return err
+	}
+	if len(operatorGroups.Items) > 1 {
+		var operatorGroupNames []string
+		for _, operatorGroup := range operatorGroups.Items {
+			operatorGroupNames = append(operatorGroupNames, operatorGroup.Name)
+		}
+		return fmt.Errorf("Only one OperatorGroup is allowed in namespace %s by OLM, found %d: %s", namespace, len(operatorGroupNames), strings.Join(operatorGroupNames, ", "))
+	}
+	return nil
+}
+
+// VerifyOperatorGroupTargetNamespaces returns an error if the namespaces targeted by the operator group according to its status don't match the expected ones
+func VerifyOperatorGroupTargetNamespaces(namespace, operatorGroupName string, expectedNamespaces []string) error {
+	operatorGroup := &olmapiv1.OperatorGroup{}
+	if exists, err := kubernetes.ResourceC(getKubeClient(namespace)).FetchWithKey(types.NamespacedName{Namespace: namespace, Name: operatorGroupName}, operatorGroup); err != nil {
+		return fmt.Errorf("Error while trying to fetch OperatorGroup %s: %v", operatorGroupName, err)
+	} else if !exists {
+		return fmt.Errorf("OperatorGroup %s not found in namespace %s", operatorGroupName, namespace)
+	}
+
+	actualNamespaces := make(map[string]bool)
+	for _, targetNamespace := range operatorGroup.Status.Namespaces {
+		actualNamespaces[targetNamespace] = true
+	}
+	var missingNamespaces []string
+	for _, expectedNamespace := range expectedNamespaces {
+		if actualNamespaces[expectedNamespace] {
+			delete(actualNamespaces, expectedNamespace)
+		} else {
+			missingNamespaces = append(missingNamespaces, expectedNamespace)
+		}
+	}
+	var unexpectedNamespaces []string
+	for targetNamespace := range actualNamespaces {
+		unexpectedNamespaces = append(unexpectedNamespaces, targetNamespace)
+	}
+
+	if len(missingNamespaces) > 0 || len(unexpectedNamespaces) > 0 {
+		sort.Strings(unexpectedNamespaces)
+		return fmt.Errorf("OperatorGroup %s in namespace %s doesn't target expected namespaces, missing: %v, unexpected: %v", operatorGroupName, namespace, missingNamespaces, unexpectedNamespaces)
+	}
+	return nil
+}
+
 // CreateNamespacedSubscriptionIfNotExist create a namespaced subscription if not exists
-func CreateNamespacedSubscriptionIfNotExist(namespace string, subscriptionName string, operatorName string, catalog OperatorCatalog, channel string) (*olmapiv1alpha1.Subscription, error) {
-	subscription := &olmapiv1alpha1.Subscription{
+func CreateNamespacedSubscriptionIfNotExist(namespace string, subscriptionName string, operatorName string, catalog OperatorCatalog, channel string, options SubscriptionOptions) (*olmapiv1alpha1.Subscription, error) {
+	subscription := newNamespacedSubscription(namespace, subscriptionName, operatorName, catalog, channel)
+	subscription.Spec.StartingCSV = options.StartingCSV
+
+	return createSubscriptionIfNotExists(subscription)
+}
+
+// CreateNamespacedSubscriptionWithConfig create a namespaced subscription with given configuration (environment, resources, node selector...) if not exists
+func CreateNamespacedSubscriptionWithConfig(namespace, subscriptionName, operatorName string, catalog OperatorCatalog, channel string, subscriptionConfig *olmapiv1alpha1.SubscriptionConfig) (*olmapiv1alpha1.Subscription, error) {
+	subscription := newNamespacedSubscription(namespace, subscriptionName, operatorName, catalog, channel)
+	if subscriptionConfig != nil {
+		subscription.Spec.Config = *subscriptionConfig
+	}
+
+	return createSubscriptionIfNotExists(subscription)
+}
+
+func newNamespacedSubscription(namespace, subscriptionName, operatorName string, catalog OperatorCatalog, channel string) *olmapiv1alpha1.Subscription {
+	return &olmapiv1alpha1.Subscription{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      subscriptionName,
 			Namespace: namespace,
@@ -273,9 +787,11 @@ func CreateNamespacedSubscriptionIfNotExist(namespace string, subscriptionName s
 			Channel:                channel,
 		},
 	}
+}
 
-	if err := kubernetes.ResourceC(kubeClient).CreateIfNotExists(subscription); err != nil {
-		return nil, fmt.Errorf("Error creating Subscription %s: %v", subscriptionName, err)
+func createSubscriptionIfNotExists(subscription *olmapiv1alpha1.Subscription) (*olmapiv1alpha1.Subscription, error) {
+	if err := kubernetes.ResourceC(getKubeClient(suscriptionNamespace)).CreateIfNotExists(subscription); err != nil {
+		return nil, fmt.Errorf("Error creating Subscription %s: %v", subscription.Name, err)
 	}
 
 	return subscription, nil
@@ -293,9 +809,46 @@ func GetClusterWideTestSubscriptions() (*olmapiv1alpha1.SubscriptionList, error)
 	return subscriptions, nil
 }
 
+// GetClusterWideTestCSVs returns CSVs installed by cluster wide subscriptions created by BDD tests
+func GetClusterWideTestCSVs() ([]olmapiv1alpha1.ClusterServiceVersion, error) {
+	subscriptions, err := GetClusterWideTestSubscriptions()
+	if err != nil {
+		return nil, err
+	}
+
+	var csvs []olmapiv1alpha1.ClusterServiceVersion
+	for _, subscription := range subscriptions.Items {
+		installedCsv := subscription.Status.InstalledCSV
+		if len(installedCsv) == 0 {
+			continue
+		}
+		csv := &olmapiv1alpha1.ClusterServiceVersion{}
+		if exists, err := kubernetes.ResourceC(kubeClient).FetchWithKey(types.NamespacedName{Namespace: subscription.Namespace, Name: installedCsv}, csv); err != nil {
+			return nil, fmt.Errorf("Error while trying to look for CSV %s: %v", installedCsv, err)
+		} else if exists {
+			csvs = append(csvs, *csv)
+		}
+	}
+	return csvs, nil
+}
+
+// GetRemainingCSVs returns names of the given CSVs which still exist in the cluster
+func GetRemainingCSVs(csvs []olmapiv1alpha1.ClusterServiceVersion) ([]string, error) {
+	var remainingCsvs []string
+	for _, csv := range csvs {
+		existingCsv := &olmapiv1alpha1.ClusterServiceVersion{}
+		if exists, err := kubernetes.ResourceC(kubeClient).FetchWithKey(types.NamespacedName{Namespace: csv.Namespace, Name: csv.Name}, existingCsv); err != nil {
+			return nil, fmt.Errorf("Error while trying to look for CSV %s: %v", csv.Name, err)
+		} else if exists {
+			remainingCsvs = append(remainingCsvs, csv.Name)
+		}
+	}
+	return remainingCsvs, nil
+}
+
 // GetSubscription returns subscription
 func GetSubscription(namespace, operatorPackageName string, catalog OperatorCatalog) (*olmapiv1alpha1.Subscription, error) {
-	subscription, err := framework.GetSubscription(kubeClient, namespace, operatorPackageName, catalog.source)
+	subscription, err := framework.GetSubscription(getKubeClient(namespace), namespace, operatorPackageName, catalog.source)
 	if err != nil {
 		return nil, err
 	} else if subscription == nil {
@@ -310,24 +863,176 @@ func GetClusterWideSubscription(operatorPackageName string, catalog OperatorCata
 	return GetSubscription(config.GetOlmNamespace(), operatorPackageName, catalog)
 }
 
+// GetOperatorCSV returns the ClusterServiceVersion installed by the operator subscription
+func GetOperatorCSV(namespace, operatorPackageName string, catalog OperatorCatalog) (*olmapiv1alpha1.ClusterServiceVersion, error) {
+	subscription, err := GetSubscription(namespace, operatorPackageName, catalog)
+	if err != nil {
+		return nil, err
+	}
+
+	installedCsv := subscription.Status.InstalledCSV
+	if len(installedCsv) == 0 {
+		return nil, fmt.Errorf("Subscription %s in namespace %s doesn't have any installed CSV yet", subscription.Name, namespace)
+	}
+
+	csv := &olmapiv1alpha1.ClusterServiceVersion{}
+	if exists, err := kubernetes.ResourceC(getKubeClient(namespace)).FetchWithKey(types.NamespacedName{Namespace: namespace, Name: installedCsv}, csv); err != nil {
+		return nil, fmt.Errorf("Error while trying to fetch ClusterServiceVersion %s: %v", installedCsv, err)
+	} else if !exists {
+		return nil, fmt.Errorf("ClusterServiceVersion %s not found in namespace %s", installedCsv, namespace)
+	}
+
+	return csv, nil
+}
+
+// GetOperatorCSVOwnedCRDs returns the CustomResourceDefinitions owned by the ClusterServiceVersion installed by the operator subscription
+func GetOperatorCSVOwnedCRDs(namespace, operatorPackageName string, catalog OperatorCatalog) ([]olmapiv1alpha1.CRDDescription, error) {
+	csv, err := GetOperatorCSV(namespace, operatorPackageName, catalog)
+	if err != nil {
+		return nil, err
+	}
+	return csv.Spec.CustomResourceDefinitions.Owned, nil
+}
+
+// ListInstalledCSVsForOperator returns the ClusterServiceVersions of the operator installed in all namespaces
+func ListInstalledCSVsForOperator(operatorPackageName string) ([]olmapiv1alpha1.ClusterServiceVersion, error) {
+	csvs := &olmapiv1alpha1.ClusterServiceVersionList{}
+	if err := kubernetes.ResourceC(kubeClient).ListWithNamespace(metav1.NamespaceAll, csvs); err != nil {
+		return nil, fmt.Errorf("Error retrieving ClusterServiceVersionList: %v", err)
+	}
+
+	// OLM label key contains the namespace where the operator is installed, so it can't be used as list selector
+	packageLabelPrefix := fmt.Sprintf("%s%s.", operatorLabelPrefix, operatorPackageName)
+	var installedCsvs []olmapiv1alpha1.ClusterServiceVersion
+	for _, csv := range csvs.Items {
+		// CSVs copied by OLM into watched namespaces are not installations
+		if csv.Status.Reason == olmapiv1alpha1.CSVReasonCopied {
+			continue
+		}
+		for label := range csv.Labels {
+			if strings.HasPrefix(label, packageLabelPrefix) {
+				installedCsvs = append(installedCsvs, csv)
+				break
+			}
+		}
+	}
+	return installedCsvs, nil
+}
+
+// GetInstalledCSVVersion returns the name of the ClusterServiceVersion installed by the operator subscription, identifying the installed version
+func GetInstalledCSVVersion(namespace, operatorPackageName string, catalog OperatorCatalog) (string, error) {
+	subscription, err := GetSubscription(namespace, operatorPackageName, catalog)
+	if err != nil {
+		return "", err
+	}
+	if len(subscription.Status.InstalledCSV) == 0 {
+		return "", fmt.Errorf("Subscription %s in namespace %s doesn't have any installed CSV yet", subscription.Name, namespace)
+	}
+	return subscription.Status.InstalledCSV, nil
+}
+
+// GetSubscriptionInstalledVersion returns the version of the CSV installed by the subscription, without the package name prefix and "v"
+func GetSubscriptionInstalledVersion(namespace, subscriptionName string) (string, error) {
+	subscription := &olmapiv1alpha1.Subscription{}
+	if exists, err := kubernetes.ResourceC(getKubeClient(namespace)).FetchWithKey(types.NamespacedName{Namespace: namespace, Name: subscriptionName}, subscription); err != nil {
+		return "", fmt.Errorf("Error while trying to look for Subscription %s: %v", subscriptionName, err)
+	} else if !exists {
+		return "", fmt.Errorf("Subscription %s not found in namespace %s", subscriptionName, namespace)
+	}
+
+	installedCsv := subscription.Status.InstalledCSV
+	if len(installedCsv) == 0 {
+		return "", fmt.Errorf("Subscription %s in namespace %s doesn't have any installed CSV yet", subscriptionName, namespace)
+	}
+	// CSVs are named by OLM convention as <package>.v<version>
+	if packagePrefix := subscription.Spec.Package + ".v"; len(subscription.Spec.Package) > 0 && strings.HasPrefix(installedCsv, packagePrefix) {
+		return strings.TrimPrefix(installedCsv, packagePrefix), nil
+	}
+	return parseCSVVersion(installedCsv)
+}
+
+// GetKogitoOperatorVersion returns the version of Kogito operator installed by OLM in the namespace
+func GetKogitoOperatorVersion(namespace string) (string, error) {
+	csvName, err := GetInstalledCSVVersion(namespace, kogitoOperatorPackageName, CustomKogitoOperatorCatalog)
+	if err != nil {
+		return "", err
+	}
+	return parseCSVVersion(csvName)
+}
+
+func parseCSVVersion(csvName string) (string, error) {
+	match := csvVersionRegex.FindStringSubmatch(csvName)
+	if match == nil {
+		return "", fmt.Errorf("Cannot parse version from ClusterServiceVersion name %s", csvName)
+	}
+	return match[1], nil
+}
+
+// RollbackOperatorToCSV sets the starting CSV of the subscription to the target CSV and deletes the currently installed CSV, letting OLM install the target CSV
+func RollbackOperatorToCSV(namespace, subscriptionName, targetCSV string) error {
+	GetLogger(namespace).Info("Rolling back operator", "subscriptionName", subscriptionName, "targetCSV", targetCSV)
+
+	subscription := &olmapiv1alpha1.Subscription{}
+	if exists, err := kubernetes.ResourceC(getKubeClient(namespace)).FetchWithKey(types.NamespacedName{Namespace: namespace, Name: subscriptionName}, subscription); err != nil {
+		return fmt.Errorf("Error while trying to look for Subscription %s: %v", subscriptionName, err)
+	} else if !exists {
+		return fmt.Errorf("Subscription %s not found in namespace %s", subscriptionName, namespace)
+	}
+
+	installedCsv := subscription.Status.InstalledCSV
+	subscription.Spec.StartingCSV = targetCSV
+	if err := kubernetes.ResourceC(getKubeClient(namespace)).Update(subscription); err != nil {
+		return fmt.Errorf("Error while updating starting CSV of Subscription %s: %v", subscriptionName, err)
+	}
+
+	if len(installedCsv) == 0 || installedCsv == targetCSV {
+		return nil
+	}
+	csv := &olmapiv1alpha1.ClusterServiceVersion{}
+	if exists, err := kubernetes.ResourceC(getKubeClient(namespace)).FetchWithKey(types.NamespacedName{Namespace: namespace, Name: installedCsv}, csv); err != nil {
+		return fmt.Errorf("Error while trying to look for CSV %s: %v", installedCsv, err)
+	} else if exists {
+		if err := kubernetes.ResourceC(getKubeClient(namespace)).Delete(csv); err != nil {
+			return fmt.Errorf("Error while deleting CSV %s: %v", installedCsv, err)
+		}
+	}
+	return nil
+}
+
+// WaitForKogitoOperatorVersionUpgraded waits for the Kogito operator installed by OLM to have the expected version and to be running
+func WaitForKogitoOperatorVersionUpgraded(namespace, expectedVersion string, timeoutInMin int) error {
+	return WaitForOnOpenshift(namespace, fmt.Sprintf("Kogito operator running with version %s", expectedVersion), timeoutInMin,
+		func() (bool, error) {
+			version, err := GetKogitoOperatorVersion(namespace)
+			if err != nil {
+				GetLogger(namespace).Debug("Kogito operator version not available yet", "error", err)
+				return false, nil
+			} else if version != expectedVersion {
+				GetLogger(namespace).Debug("Kogito operator doesn't have expected version yet", "version", version, "expectedVersion", expectedVersion)
+				return false, nil
+			}
+			return IsKogitoOperatorRunning(namespace)
+		})
+}
+
 // DeleteSubscription deletes Subscription and related objects
 func DeleteSubscription(subscription *olmapiv1alpha1.Subscription) error {
 	installedCsv := subscription.Status.InstalledCSV
 	suscriptionNamespace := subscription.Namespace
 
 	// Delete Subscription
-	if err := kubernetes.ResourceC(kubeClient).Delete(subscription); err != nil {
+	if err := kubernetes.ResourceC(getKubeClient(suscriptionNamespace)).Delete(subscription); err != nil {
 		return err
 	}
 
 	// Delete related CSV
 	csv := &olmapiv1alpha1.ClusterServiceVersion{}
-	exists, err := kubernetes.ResourceC(kubeClient).FetchWithKey(types.NamespacedName{Namespace: suscriptionNamespace, Name: installedCsv}, csv)
+	exists, err := kubernetes.ResourceC(getKubeClient(suscriptionNamespace)).FetchWithKey(types.NamespacedName{Namespace: suscriptionNamespace, Name: installedCsv}, csv)
 	if err != nil {
 		return err
 	}
 	if exists {
-		if err := kubernetes.ResourceC(kubeClient).Delete(csv); err != nil {
+		if err := kubernetes.ResourceC(getKubeClient(suscriptionNamespace)).Delete(csv); err != nil {
 			return err
 		}
 	}
@@ -340,17 +1045,27 @@ func GetOperatorImageNameAndTag() string {
 	return fmt.Sprintf("%s:%s", config.GetOperatorImageName(), config.GetOperatorImageTag())
 }
 
-// WaitForMongoDBOperatorRunning waits for MongoDB operator to be running
+// WaitForMongoDBOperatorRunning waits for MongoDB operator to be running with all its pods ready
 func WaitForMongoDBOperatorRunning(namespace string) error {
-	return WaitForOnOpenshift(namespace, "MongoDB operator running", mongoDBOperatorTimeoutInMin,
+	if err := WaitForOnOpenshift(namespace, "MongoDB operator running", mongoDBOperatorTimeoutInMin,
 		func() (bool, error) {
 			return isMongoDBOperatorRunning(namespace)
-		})
+		}); err != nil {
+		return err
+	}
+
+	deployment, err := GetDeployment(namespace, infrastructure.MongoDBOperatorName)
+	if err != nil {
+		return err
+	} else if deployment == nil {
+		return fmt.Errorf("Deployment %s not found in namespace %s", infrastructure.MongoDBOperatorName, namespace)
+	}
+	return WaitForPodReady(namespace, deployment.Spec.Selector.MatchLabels, mongoDBOperatorTimeoutInMin)
 }
 
 func isMongoDBOperatorRunning(namespace string) (bool, error) {
 	context := &operator.Context{
-		Client: kubeClient,
+		Client: getKubeClient(namespace),
 		Log:    logger.GetLogger(namespace),
 		Scheme: meta.GetRegisteredSchema(),
 	}
@@ -366,24 +1081,138 @@ func isMongoDBOperatorRunning(namespace string) (bool, error) {
 	return exists, nil
 }
 
+// WaitForInfinispanOperatorRunning waits for Infinispan operator to be running
+func WaitForInfinispanOperatorRunning(namespace string) error {
+	return WaitForInfinispanOperatorRunningWithTimeout(namespace, infinispanOperatorTimeoutInMin)
+}
+
+// WaitForInfinispanOperatorRunningWithTimeout waits for Infinispan operator to be running with all its pods ready within the given timeout
+func WaitForInfinispanOperatorRunningWithTimeout(namespace string, timeoutInMin int) error {
+	if err := WaitForOnOpenshift(namespace, "Infinispan operator running", timeoutInMin,
+		func() (bool, error) {
+			return isInfinispanOperatorRunning(namespace)
+		}); err != nil {
+		return err
+	}
+
+	deployment, err := getInfinispanOperatorDeployment(namespace)
+	if err != nil {
+		return err
+	} else if deployment == nil {
+		return fmt.Errorf("Infinispan operator Deployment not found in namespace %s", namespace)
+	}
+	return WaitForPodReady(namespace, deployment.Spec.Selector.MatchLabels, timeoutInMin)
+}
+
+func isInfinispanOperatorRunning(namespace string) (bool, error) {
+	if !IsInfinispanAvailable(namespace) {
+		GetLogger(namespace).Debug("Infinispan CRDs not available yet")
+		return false, nil
+	}
+
+	deployment, err := getInfinispanOperatorDeployment(namespace)
+	if err != nil || deployment == nil {
+		return false, err
+	}
+	return isDeploymentRolledOutToSpec(deployment), nil
+}
+
+// getInfinispanOperatorDeployment returns the Infinispan operator Deployment, whatever name the installed version gives it, nil if it doesn't exist
+func getInfinispanOperatorDeployment(namespace string) (*v1.Deployment, error) {
+	for _, deploymentName := range infinispanOperatorDeploymentNames {
+		deployment, err := GetDeployment(namespace, deploymentName)
+		if err != nil {
+			return nil, fmt.Errorf("Error while trying to look for Deployment %s: %v ", deploymentName, err)
+		} else if deployment != nil {
+			return deployment, nil
+		}
+	}
+	return nil, nil
+}
+
+// WaitForKeycloakOperatorRunning waits for Keycloak operator to be running
+func WaitForKeycloakOperatorRunning(namespace string) error {
+	return WaitForKeycloakOperatorRunningWithTimeout(namespace, keycloakOperatorTimeoutInMin)
+}
+
+// WaitForKeycloakOperatorRunningWithTimeout waits for Keycloak operator to be running within the given timeout
+func WaitForKeycloakOperatorRunningWithTimeout(namespace string, timeoutInMin int) error {
+	return WaitForOnOpenshift(namespace, "Keycloak operator running", timeoutInMin,
+		func() (bool, error) {
+			return isKeycloakOperatorRunning(namespace)
+		})
+}
+
+func isKeycloakOperatorRunning(namespace string) (bool, error) {
+	deployment, err := GetDeployment(namespace, keycloakOperatorDeploymentName)
+	if err != nil {
+		return false, fmt.Errorf("Error while trying to look for Deployment %s: %v ", keycloakOperatorDeploymentName, err)
+	} else if deployment == nil {
+		return false, nil
+	}
+	return isDeploymentRolledOutToSpec(deployment), nil
+}
+
+// WaitForAllKogitoOperatorDependenciesRunning waits concurrently for Infinispan, Kafka and Keycloak operators to be running
+func WaitForAllKogitoOperatorDependenciesRunning(namespace string, timeoutInMin int) error {
+	dependencyWaits := map[string]func() error{
+		kogitoInfinispanDependencyName: func() error {
+			return WaitForInfinispanOperatorRunningWithTimeout(namespace, timeoutInMin)
+		},
+		kogitoKafkaDependencyName: func() error {
+			return WaitForClusterWideOperatorRunning(kafkaOperatorPackageName, CommunityCatalog, timeoutInMin)
+		},
+		kogitoKeycloakDependencyName: func() error {
+			return WaitForKeycloakOperatorRunningWithTimeout(namespace, timeoutInMin)
+		},
+	}
+
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+	var dependencyErrors []string
+	for dependencyName, waitForDependency := range dependencyWaits {
+		wg.Add(1)
+		go func(dependencyName string, waitForDependency func() error) {
+			defer wg.Done()
+			if err := waitForDependency(); err != nil {
+				mutex.Lock()
+				defer mutex.Unlock()
+				dependencyErrors = append(dependencyErrors, fmt.Sprintf("%s: %v", dependencyName, err))
+			}
+		}(dependencyName, waitForDependency)
+	}
+	wg.Wait()
+
+	if len(dependencyErrors) > 0 {
+		return fmt.Errorf("Kogito operator dependencies not running: %s", strings.Join(dependencyErrors, "; "))
+	}
+	return nil
+}
+
 // CreateKogitoOperatorCatalogSource create a Kogito operator catalog source
 func CreateKogitoOperatorCatalogSource() (*olmapiv1alpha1.CatalogSource, error) {
-	GetLogger(openShiftMarketplaceNamespace).Info("Installing custom Kogito operator CatalogSource", "name", kogitoCatalogSourceName, "namespace", openShiftMarketplaceNamespace)
+	return CreateCustomCatalogSource(openShiftMarketplaceNamespace, kogitoCatalogSourceName, config.GetOperatorCatalogImage(), "Catalog containing custom Kogito bundle used for BDD tests", CatalogSourceOptions{})
+}
+
+// CreateCustomCatalogSource create a custom operator catalog source
+func CreateCustomCatalogSource(namespace, name, image, description string, options CatalogSourceOptions) (*olmapiv1alpha1.CatalogSource, error) {
+	GetLogger(namespace).Info("Installing custom operator CatalogSource", "name", name, "namespace", namespace)
 
 	cs := &olmapiv1alpha1.CatalogSource{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      kogitoCatalogSourceName,
-			Namespace: openShiftMarketplaceNamespace,
+			Name:      name,
+			Namespace: namespace,
 		},
 		Spec: olmapiv1alpha1.CatalogSourceSpec{
-			SourceType:  olmapiv1alpha1.SourceTypeGrpc,
-			Image:       config.GetOperatorCatalogImage(),
-			Description: "Catalog containing custom Kogito bundle used for BDD tests",
+			SourceType:     olmapiv1alpha1.SourceTypeGrpc,
+			Image:          image,
+			Description:    description,
+			UpdateStrategy: options.UpdateStrategy,
 		},
 	}
 
-	if err := kubernetes.ResourceC(kubeClient).CreateIfNotExists(cs); err != nil {
-		return nil, fmt.Errorf("Error creating CatalogSource %s: %v", kogitoCatalogSourceName, err)
+	if err := kubernetes.ResourceC(getKubeClient(namespace)).CreateIfNotExists(cs); err != nil {
+		return nil, fmt.Errorf("Error creating CatalogSource %s: %v", name, err)
 	}
 
 	return cs, nil
@@ -398,37 +1227,108 @@ func WaitForKogitoOperatorCatalogSourceReady() error {
 }
 
 func isKogitoOperatorCatalogSourceReady() (bool, error) {
+	cs, err := getCatalogSource(openShiftMarketplaceNamespace, kogitoCatalogSourceName)
+	if err != nil || cs == nil {
+		return false, err
+	}
+
+	if cs.Status.GRPCConnectionState == nil || cs.Status.GRPCConnectionState.LastObservedState != catalogSourceReadyState {
+		return false, nil
+	}
+	return true, nil
+}
+
+// CheckSubscriptionCatalogSourceHealthy checks that the CatalogSource referenced by a subscription is reachable
+func CheckSubscriptionCatalogSourceHealthy(namespace string, catalog OperatorCatalog) error {
+	GetLogger(namespace).Debug("Checking CatalogSource connection", "name", catalog.source, "namespace", catalog.namespace)
+
+	cs, err := getCatalogSource(catalog.namespace, catalog.source)
+	if err != nil {
+		return err
+	} else if cs == nil {
+		return fmt.Errorf("CatalogSource %s not found in namespace %s", catalog.source, catalog.namespace)
+	}
+
+	if cs.Status.GRPCConnectionState == nil {
+		return fmt.Errorf("CatalogSource %s in namespace %s doesn't report any GRPC connection state yet", catalog.source, catalog.namespace)
+	} else if state := cs.Status.GRPCConnectionState.LastObservedState; state != catalogSourceReadyState {
+		return fmt.Errorf("CatalogSource %s in namespace %s is not ready, last observed GRPC connection state is '%s' at address '%s'", catalog.source, catalog.namespace, state, cs.Status.GRPCConnectionState.Address)
+	}
+	return nil
+}
+
+func getCatalogSource(namespace, name string) (*olmapiv1alpha1.CatalogSource, error) {
 	cs := &olmapiv1alpha1.CatalogSource{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      kogitoCatalogSourceName,
-			Namespace: openShiftMarketplaceNamespace,
+			Name:      name,
+			Namespace: namespace,
 		},
 	}
-	if exists, err := kubernetes.ResourceC(kubeClient).Fetch(cs); err != nil {
-		return false, fmt.Errorf("Error while trying to look for CatalogSource %s: %v ", kogitoCatalogSourceName, err)
+	if exists, err := kubernetes.ResourceC(getKubeClient(namespace)).Fetch(cs); err != nil {
+		return nil, fmt.Errorf("Error while trying to look for CatalogSource %s: %v ", name, err)
 	} else if !exists {
-		return false, nil
+		return nil, nil
 	}
+	return cs, nil
+}
 
-	if cs.Status.GRPCConnectionState == nil || cs.Status.GRPCConnectionState.LastObservedState != "READY" {
-		return false, nil
+// GetCatalogSourceGRPCAddress returns the address of the registry server serving the CatalogSource content
+func GetCatalogSourceGRPCAddress(namespace, catalogSourceName string) (string, error) {
+	cs, err := getCatalogSource(namespace, catalogSourceName)
+	if err != nil {
+		return "", err
+	} else if cs == nil {
+		return "", fmt.Errorf("CatalogSource %s not found in namespace %s", catalogSourceName, namespace)
 	}
-	return true, nil
+
+	registryService := cs.Status.RegistryServiceStatus
+	if registryService == nil || len(registryService.ServiceName) == 0 {
+		return "", fmt.Errorf("CatalogSource %s in namespace %s doesn't report any registry service yet", catalogSourceName, namespace)
+	}
+	serviceNamespace := registryService.ServiceNamespace
+	if len(serviceNamespace) == 0 {
+		serviceNamespace = namespace
+	}
+	return fmt.Sprintf("%s.%s.svc:%d", registryService.ServiceName, serviceNamespace, catalogSourceGRPCPort), nil
+}
+
+// QueryCatalogSourcePackages returns the sorted names of the packages served by the CatalogSource.
+// Packages are queried through the PackageManifests of OLM package server, the registry server at GetCatalogSourceGRPCAddress is not called directly.
+func QueryCatalogSourcePackages(namespace, catalogSourceName string) ([]string, error) {
+	// Package server exposes the content queried from the registry server of each CatalogSource as PackageManifests
+	packageManifests := &olmpackagev1.PackageManifestList{}
+	if err := kubernetes.ResourceC(getKubeClient(namespace)).ListWithNamespace(namespace, packageManifests); err != nil {
+		return nil, fmt.Errorf("Error while listing PackageManifests in namespace %s: %v", namespace, err)
+	}
+
+	var packages []string
+	for _, packageManifest := range packageManifests.Items {
+		if packageManifest.Status.CatalogSource == catalogSourceName && packageManifest.Status.CatalogSourceNamespace == namespace {
+			packages = append(packages, packageManifest.Status.PackageName)
+		}
+	}
+	sort.Strings(packages)
+	return packages, nil
 }
 
 // DeleteKogitoOperatorCatalogSource delete a Kogito operator catalog source
 func DeleteKogitoOperatorCatalogSource() error {
-	GetLogger(openShiftMarketplaceNamespace).Info("Deleting custom Kogito operator CatalogSource", "name", kogitoCatalogSourceName, "namespace", openShiftMarketplaceNamespace)
+	return DeleteCustomCatalogSource(openShiftMarketplaceNamespace, kogitoCatalogSourceName)
+}
+
+// DeleteCustomCatalogSource delete a custom operator catalog source
+func DeleteCustomCatalogSource(namespace, name string) error {
+	GetLogger(namespace).Info("Deleting custom operator CatalogSource", "name", name, "namespace", namespace)
 
 	cs := &olmapiv1alpha1.CatalogSource{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      kogitoCatalogSourceName,
-			Namespace: openShiftMarketplaceNamespace,
+			Name:      name,
+			Namespace: namespace,
 		},
 	}
 
-	if err := kubernetes.ResourceC(kubeClient).Delete(cs); err != nil {
-		return fmt.Errorf("Error deleting CatalogSource %s: %v", kogitoCatalogSourceName, err)
+	if err := kubernetes.ResourceC(getKubeClient(namespace)).Delete(cs); err != nil {
+		return fmt.Errorf("Error deleting CatalogSource %s: %v", name, err)
 	}
 
 	return nil
@@ -15,6 +15,8 @@
 package framework
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -36,8 +38,34 @@ import (
 const (
 	fileFlags      = os.O_CREATE | os.O_WRONLY | os.O_APPEND
 	permissionMode = 0666
+
+	defaultPollIntervalSeconds = 1
 )
 
+// WaitOptions configures how a condition is polled
+type WaitOptions struct {
+	// InitialDelaySeconds is the time to wait before polling the condition for the first time. It is part of the timeout.
+	InitialDelaySeconds int
+	// PollIntervalSeconds is the time between two condition checks. Defaults to 1 second.
+	PollIntervalSeconds int
+	// Context allows to cancel the wait externally, ErrWaitCanceled is returned in that case. Wait can't be canceled if nil.
+	Context context.Context
+}
+
+// ErrWaitCanceled is returned when a wait is stopped by the cancellation of its context, distinct from a timeout
+var ErrWaitCanceled = errors.New("wait canceled")
+
+// DefaultWaitOptions returns the options used by WaitFor, polling every second without initial delay
+func DefaultWaitOptions() WaitOptions {
+	return WaitOptions{PollIntervalSeconds: defaultPollIntervalSeconds}
+}
+
+// WithContext returns a copy of the options where the wait is canceled together with the given context
+func (options WaitOptions) WithContext(ctx context.Context) WaitOptions {
+	options.Context = ctx
+	return options
+}
+
 // GenerateNamespaceName generates a namespace name, taking configuration into account (local or not)
 func GenerateNamespaceName(prefix string) string {
 	rand.Seed(time.Now().UnixNano())
@@ -79,16 +107,43 @@ func ReadFromURI(uri string) (string, error) {
 
 // WaitFor waits for a specification condition to be met or until one error condition is met
 func WaitFor(namespace, display string, timeout time.Duration, condition func() (bool, error), errorConditions ...func() (bool, error)) error {
+	return WaitForWithOptions(namespace, display, timeout, DefaultWaitOptions(), condition, errorConditions...)
+}
+
+// WaitForWithOptions waits for a specification condition to be met or until one error condition is met, polling as configured by the given options
+func WaitForWithOptions(namespace, display string, timeout time.Duration, options WaitOptions, condition func() (bool, error), errorConditions ...func() (bool, error)) error {
 	GetLogger(namespace).Info(fmt.Sprintf("Wait %s for %s", timeout.String(), display))
 
 	timeoutChan := time.After(timeout)
-	tick := time.NewTicker(1 * time.Second)
+	// Receiving from a nil channel blocks forever, wait can't be canceled without context
+	var canceledChan <-chan struct{}
+	if options.Context != nil {
+		canceledChan = options.Context.Done()
+	}
+	if options.InitialDelaySeconds > 0 {
+		GetLogger(namespace).Debug(fmt.Sprintf("Delaying first check of %s by %d seconds", display, options.InitialDelaySeconds))
+		select {
+		case <-timeoutChan:
+			return fmt.Errorf("Timeout waiting for %s", display)
+		case <-canceledChan:
+			return fmt.Errorf("%w while waiting for %s: %v", ErrWaitCanceled, display, options.Context.Err())
+		case <-time.After(time.Duration(options.InitialDelaySeconds) * time.Second):
+		}
+	}
+
+	pollIntervalSeconds := options.PollIntervalSeconds
+	if pollIntervalSeconds <= 0 {
+		pollIntervalSeconds = defaultPollIntervalSeconds
+	}
+	tick := time.NewTicker(time.Duration(pollIntervalSeconds) * time.Second)
 	defer tick.Stop()
 
 	for {
 		select {
 		case <-timeoutChan:
 			return fmt.Errorf("Timeout waiting for %s", display)
+		case <-canceledChan:
+			return fmt.Errorf("%w while waiting for %s: %v", ErrWaitCanceled, display, options.Context.Err())
 		case <-tick.C:
 			running, err := condition()
 			if err != nil {
@@ -110,6 +165,17 @@ func WaitFor(namespace, display string, timeout time.Duration, condition func()
 	}
 }
 
+// RetryStep executes the function and retries it up to maxRetries times with given delay while it returns an error, last error is returned
+func RetryStep(fn func() error, maxRetries int, delay time.Duration) error {
+	err := fn()
+	for retry := 1; err != nil && retry <= maxRetries; retry++ {
+		GetMainLogger().Warn(fmt.Sprintf("Step failed, retrying in %s (%d/%d) => %v", delay.String(), retry, maxRetries, err))
+		time.Sleep(delay)
+		err = fn()
+	}
+	return err
+}
+
 // PrintDataMap prints a formatted dataMap using the given writer
 func PrintDataMap(keys []string, dataMaps []map[string]string, writer io.StringWriter) error {
 	// Get size of strings to be written, to be able to format correctly
@@ -174,9 +240,14 @@ func CreateFolder(folder string) error {
 	return os.MkdirAll(folder, os.ModePerm)
 }
 
+// GetTemporaryFoldersLocation returns the default directory for temporary files, in which temporary folders are created
+func GetTemporaryFoldersLocation() string {
+	return os.TempDir()
+}
+
 // CreateTemporaryFolder creates a folder in default directory for temporary files
 func CreateTemporaryFolder(folderPrefix string) (string, error) {
-	return ioutil.TempDir("", folderPrefix)
+	return ioutil.TempDir(GetTemporaryFoldersLocation(), folderPrefix)
 }
 
 // DeleteFolder deletes a folder and all its subfolders
@@ -114,7 +114,7 @@ func installInfinispanUsingYaml(namespace string) error {
 }
 
 func waitForInfinispanUsingYamlRunning(namespace string) error {
-	return framework.WaitForPodsWithLabel(namespace, "name", "infinispan-operator", 1, 3)
+	return framework.WaitForInfinispanOperatorRunning(namespace)
 }
 
 func uninstallInfinispanUsingYaml(namespace string) error {
@@ -36,6 +36,8 @@ var (
 type ServiceInstaller interface {
 	// Install the service into cloud to serve the namespace
 	Install(namespace string) error
+	// Install the service into cloud to serve the namespace, without waiting for it to be up and running
+	InstallWithoutWaiting(namespace string) error
 	// Return all CRs of this service which exists in this namespace
 	getAllCrsInNamespace(namespace string) ([]kubernetes.ResourceObject, error)
 	// Returns service name for logging purposes
@@ -157,16 +159,21 @@ type YamlNamespacedServiceInstaller struct {
 
 // Install the namespaced service using YAML files into cloud
 func (installer *YamlNamespacedServiceInstaller) Install(namespace string) error {
+	if err := installer.InstallWithoutWaiting(namespace); err != nil {
+		return err
+	}
+
+	return installer.WaitForNamespacedServiceRunning(namespace)
+}
+
+// InstallWithoutWaiting installs the namespaced service using YAML files into cloud, without waiting for it to be running
+func (installer *YamlNamespacedServiceInstaller) InstallWithoutWaiting(namespace string) error {
 	// Store service installer for namespace to use for uninstalling purposes
 	if sis, loaded := installedNamespacedServices.LoadOrStore(namespace, []NamespacedServiceInstaller{installer}); loaded {
 		installedNamespacedServices.Store(namespace, append(sis.([]NamespacedServiceInstaller), installer))
 	}
 
-	if err := installer.InstallNamespacedYaml(namespace); err != nil {
-		return err
-	}
-
-	return installer.WaitForNamespacedServiceRunning(namespace)
+	return installer.InstallNamespacedYaml(namespace)
 }
 
 func (installer *YamlNamespacedServiceInstaller) getAllCrsInNamespace(namespace string) ([]kubernetes.ResourceObject, error) {
@@ -213,19 +220,24 @@ type YamlClusterWideServiceInstaller struct {
 
 // Install the cluster wide service using YAML files into cloud
 func (installer *YamlClusterWideServiceInstaller) Install(namespace string) error {
+	if err := installer.InstallWithoutWaiting(namespace); err != nil {
+		return err
+	}
+
+	return installer.WaitForClusterYamlServiceRunning()
+}
+
+// InstallWithoutWaiting installs the cluster wide service using YAML files into cloud, without waiting for it to be running
+func (installer *YamlClusterWideServiceInstaller) InstallWithoutWaiting(namespace string) error {
 	// Store cluster wide service installer to use for uninstalling purposes
 	if _, loaded := installedClusterWideServices.LoadOrStore(installer, true); loaded {
-		// Should be running already, wait until it is up
-		return installer.WaitForClusterYamlServiceRunning()
+		// Should be installed already
+		return nil
 	}
 
 	monitorNamespace(installer.InstallationNamespace)
 
-	if err := installer.InstallClusterYaml(); err != nil {
-		return err
-	}
-
-	return installer.WaitForClusterYamlServiceRunning()
+	return installer.InstallClusterYaml()
 }
 
 func (installer *YamlClusterWideServiceInstaller) getAllCrsInNamespace(namespace string) ([]kubernetes.ResourceObject, error) {
@@ -267,16 +279,21 @@ type OlmNamespacedServiceInstaller struct {
 
 // Install the namespaced service using OLM into cloud
 func (installer *OlmNamespacedServiceInstaller) Install(namespace string) error {
+	if err := installer.InstallWithoutWaiting(namespace); err != nil {
+		return err
+	}
+
+	return framework.WaitForOperatorRunning(namespace, installer.SubscriptionName, installer.Catalog, installer.InstallationTimeoutInMinutes)
+}
+
+// InstallWithoutWaiting installs the namespaced service using OLM into cloud, without waiting for the operator to be running
+func (installer *OlmNamespacedServiceInstaller) InstallWithoutWaiting(namespace string) error {
 	// Store service installer for namespace to use for uninstalling purposes
 	if sis, loaded := installedNamespacedServices.LoadOrStore(namespace, []NamespacedServiceInstaller{installer}); loaded {
 		installedNamespacedServices.Store(namespace, append(sis.([]NamespacedServiceInstaller), installer))
 	}
 
-	if err := framework.InstallOperator(namespace, installer.SubscriptionName, installer.Channel, installer.Catalog); err != nil {
-		return err
-	}
-
-	return framework.WaitForOperatorRunning(namespace, installer.SubscriptionName, installer.Catalog, installer.InstallationTimeoutInMinutes)
+	return framework.InstallOperator(namespace, installer.SubscriptionName, installer.Channel, installer.Catalog)
 }
 
 func (installer *OlmNamespacedServiceInstaller) getAllCrsInNamespace(namespace string) ([]kubernetes.ResourceObject, error) {
@@ -322,19 +339,24 @@ type OlmClusterWideServiceInstaller struct {
 
 // Install the cluster wide service using OLM into cloud
 func (installer *OlmClusterWideServiceInstaller) Install(namespace string) error {
-	// Store cluster wide service installer to use for uninstalling purposes
-	if _, loaded := installedClusterWideServices.LoadOrStore(installer, true); loaded {
-		// Should be running already, wait until it is up
-		return framework.WaitForClusterWideOperatorRunning(installer.SubscriptionName, installer.Catalog, installer.InstallationTimeoutInMinutes)
-	}
-
-	if err := framework.InstallClusterWideOperator(installer.SubscriptionName, installer.Channel, installer.Catalog); err != nil {
+	if err := installer.InstallWithoutWaiting(namespace); err != nil {
 		return err
 	}
 
 	return framework.WaitForClusterWideOperatorRunning(installer.SubscriptionName, installer.Catalog, installer.InstallationTimeoutInMinutes)
 }
 
+// InstallWithoutWaiting installs the cluster wide service using OLM into cloud, without waiting for the operator to be running
+func (installer *OlmClusterWideServiceInstaller) InstallWithoutWaiting(namespace string) error {
+	// Store cluster wide service installer to use for uninstalling purposes
+	if _, loaded := installedClusterWideServices.LoadOrStore(installer, true); loaded {
+		// Should be installed already
+		return nil
+	}
+
+	return framework.InstallClusterWideOperator(installer.SubscriptionName, installer.Channel, installer.Catalog)
+}
+
 func (installer *OlmClusterWideServiceInstaller) getAllCrsInNamespace(namespace string) ([]kubernetes.ResourceObject, error) {
 	return installer.GetAllClusterWideOlmCrsInNamespace(namespace)
 }
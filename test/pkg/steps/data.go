@@ -16,12 +16,16 @@ package steps
 
 import (
 	"fmt"
+	"io/ioutil"
+	"os"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/cucumber/godog"
 	"github.com/kiegroup/kogito-operator/api/v1beta1"
+	"github.com/kiegroup/kogito-operator/core/client"
+	"github.com/kiegroup/kogito-operator/meta"
 	"github.com/kiegroup/kogito-operator/test/pkg/config"
 	"github.com/kiegroup/kogito-operator/test/pkg/framework"
 	imgv1 "github.com/openshift/api/image/v1"
@@ -43,6 +47,7 @@ type Data struct {
 	KogitoExamplesLocation string
 	ScenarioName           string
 	ScenarioContext        map[string]string
+	Client                 *client.Client
 }
 
 // RegisterAllSteps register all steps available to the test suite
@@ -86,10 +91,18 @@ func (data *Data) RegisterLogsKubernetesObjects(objects ...runtime.Object) {
 func (data *Data) BeforeScenario(scenario *godog.Scenario) error {
 	data.StartTime = time.Now()
 	data.Namespace = getNamespaceName()
-	data.KogitoExamplesLocation = createTemporaryFolder()
 	data.ScenarioName = scenario.GetName()
 	data.ScenarioContext = map[string]string{}
 
+	if err := data.ValidateDataPaths(); err != nil {
+		return err
+	}
+	data.KogitoExamplesLocation = createTemporaryFolder()
+
+	if err := data.initClient(); err != nil {
+		return err
+	}
+
 	var err error
 	framework.GetLogger(data.Namespace).Info(fmt.Sprintf("Scenario %s", scenario.GetName()))
 	go func() {
@@ -102,6 +115,38 @@ func (data *Data) BeforeScenario(scenario *godog.Scenario) error {
 	return nil
 }
 
+// ValidateDataPaths checks that the base directory of local paths used by the scenario is set and writable
+func (data *Data) ValidateDataPaths() error {
+	baseLocation := framework.GetTemporaryFoldersLocation()
+	if len(baseLocation) == 0 {
+		return fmt.Errorf("Temporary folders location is not set")
+	}
+
+	// Examples are cloned and built into a folder created in the location
+	checkFile, err := ioutil.TempFile(baseLocation, ".write-check")
+	if err != nil {
+		return fmt.Errorf("Temporary folders location %s is not writable: %v", baseLocation, err)
+	}
+	checkFile.Close()
+	return os.Remove(checkFile.Name())
+}
+
+// initClient sets the Kubernetes client used by the scenario, a dedicated one is created for the scenario namespace in parallel mode
+func (data *Data) initClient() error {
+	if !config.IsParallel() {
+		data.Client = framework.GetKubeClient()
+		return nil
+	}
+
+	scenarioClient, err := framework.NewKubeClient(meta.GetRegisteredSchema())
+	if err != nil {
+		return fmt.Errorf("Error creating Kubernetes client for scenario: %v", err)
+	}
+	data.Client = scenarioClient
+	framework.SetNamespaceKubeClient(data.Namespace, scenarioClient)
+	return nil
+}
+
 func getNamespaceName() string {
 	if namespaceName := config.GetNamespaceName(); len(namespaceName) > 0 {
 		return namespaceName
@@ -134,6 +179,12 @@ func createTemporaryFolder() string {
 // AfterScenario executes some actions on data after a scenario is finished
 func (data *Data) AfterScenario(scenario *godog.Scenario, err error) error {
 	error := framework.OperateOnNamespaceIfExists(data.Namespace, func(namespace string) error {
+		// KogitoBuilds exist only in scenarios using build steps
+		if _, kogitoBuildDeployed := data.ScenarioContext[kogitoBuildDeployedContextKey]; err != nil && kogitoBuildDeployed {
+			if err := framework.LogKogitoBuildFailureReasons(namespace); err != nil {
+				framework.GetMainLogger().Error(err, "Error logging KogitoBuild failure reasons", "namespace", namespace)
+			}
+		}
 		if err := framework.StopPodLogCollector(namespace); err != nil {
 			framework.GetMainLogger().Error(err, "Error stopping log collector", "namespace", namespace)
 		}
@@ -149,9 +200,15 @@ func (data *Data) AfterScenario(scenario *godog.Scenario, err error) error {
 		return nil
 	})
 
+	// Cluster wide binding of the metrics reader is not removed with the namespace
+	if err := framework.DeleteKogitoOperatorMetricsReader(data.Namespace); err != nil {
+		framework.GetMainLogger().Error(err, "Error deleting Kogito operator metrics reader", "namespace", data.Namespace)
+	}
+
 	handleScenarioResult(data, scenario, err)
 	logScenarioDuration(data)
 	deleteTemporaryExamplesFolder(data)
+	framework.UnsetNamespaceKubeClient(data.Namespace)
 
 	if error != nil {
 		return error
@@ -174,6 +231,7 @@ func logScenarioDuration(data *Data) {
 	endTime := time.Now()
 	duration := endTime.Sub(data.StartTime)
 	framework.GetLogger(data.Namespace).Info("Scenario duration", "duration", duration.String())
+	framework.RecordBDDScenarioDuration(data.ScenarioName, duration)
 }
 
 func handleScenarioResult(data *Data, scenario *godog.Scenario, err error) {
@@ -36,15 +36,15 @@ func registerKogitoDeployFilesSteps(ctx *godog.ScenarioContext, data *Data) {
 
 func (data *Data) deployFileFromExampleService(runtimeType, file, serviceName string) error {
 	sourceFilePath := fmt.Sprintf(`%s/%s/%s/%s`, data.KogitoExamplesLocation, serviceName, sourceLocation, file)
-	return deploySourceFilesFromPath(data.Namespace, runtimeType, serviceName, sourceFilePath)
+	return data.deploySourceFilesFromPath(data.Namespace, runtimeType, serviceName, sourceFilePath)
 }
 
 func (data *Data) deployFolderFromExampleService(runtimeType, serviceName string) error {
 	sourceFolderPath := fmt.Sprintf(`%s/%s/%s`, data.KogitoExamplesLocation, serviceName, sourceLocation)
-	return deploySourceFilesFromPath(data.Namespace, runtimeType, serviceName, sourceFolderPath)
+	return data.deploySourceFilesFromPath(data.Namespace, runtimeType, serviceName, sourceFolderPath)
 }
 
-func deploySourceFilesFromPath(namespace, runtimeType, serviceName, path string) error {
+func (data *Data) deploySourceFilesFromPath(namespace, runtimeType, serviceName, path string) error {
 	framework.GetLogger(namespace).Info("Deploying example with source files", "runtimeType", runtimeType, "serviceName", serviceName, "path", path)
 
 	buildHolder, err := getKogitoBuildConfiguredStub(namespace, runtimeType, serviceName, nil)
@@ -55,7 +55,7 @@ func deploySourceFilesFromPath(namespace, runtimeType, serviceName, path string)
 	buildHolder.KogitoBuild.GetSpec().SetType(api.LocalSourceBuildType)
 	buildHolder.KogitoBuild.GetSpec().GetGitSource().SetURI(path)
 
-	err = framework.DeployKogitoBuild(namespace, framework.GetDefaultInstallerType(), buildHolder)
+	err = data.deployKogitoBuild(namespace, buildHolder)
 	if err != nil {
 		return err
 	}
@@ -15,12 +15,20 @@
 package steps
 
 import (
+	"os"
+	"time"
+
 	"github.com/cucumber/godog"
 	"github.com/kiegroup/kogito-operator/test/pkg/config"
 	"github.com/kiegroup/kogito-operator/test/pkg/framework"
+)
+
+const (
+	examplesCloneDepth = 1
 
-	git "gopkg.in/src-d/go-git.v4"
-	"gopkg.in/src-d/go-git.v4/plumbing"
+	// examplesCloneRetries number of clone retries in case of transient network issues
+	examplesCloneRetries    = 3
+	examplesCloneRetryDelay = 10 * time.Second
 )
 
 // registerGitSteps register all existing GIT steps
@@ -31,32 +39,13 @@ func registerGitSteps(ctx *godog.ScenarioContext, data *Data) {
 func (data *Data) cloneKogitoExamplesIntoLocalDirectory() error {
 	framework.GetLogger(data.Namespace).Info("Cloning kogito examples", "URI", config.GetExamplesRepositoryURI(), "branch", config.GetExamplesRepositoryRef(), "clonedLocation", data.KogitoExamplesLocation)
 
-	cloneOptions := &git.CloneOptions{
-		URL:          config.GetExamplesRepositoryURI(),
-		SingleBranch: true,
-	}
-
-	var err error
-	reference := config.GetExamplesRepositoryRef()
-	if len(reference) == 0 {
-		err = cloneExamples(data.KogitoExamplesLocation, cloneOptions)
-	} else {
-		// Try cloning as branch reference
-		cloneOptions.ReferenceName = plumbing.NewBranchReferenceName(reference)
-		err = cloneExamples(data.KogitoExamplesLocation, cloneOptions)
-		// If branch clone was successful then return, otherwise try other cloning options
-		if err == nil {
-			return nil
+	// Only the latest commit is needed to build examples
+	cloneConfig := framework.NewCloneConfig(config.GetExamplesRepositoryURI()).WithShallowClone(examplesCloneDepth)
+	return framework.RetryStep(func() error {
+		// Remove content left by a failed clone attempt
+		if err := os.RemoveAll(data.KogitoExamplesLocation); err != nil {
+			return err
 		}
-
-		// If branch cloning failed then try cloning as tag
-		cloneOptions.ReferenceName = plumbing.NewTagReferenceName(reference)
-		err = cloneExamples(data.KogitoExamplesLocation, cloneOptions)
-	}
-	return err
-}
-
-func cloneExamples(examplesLocation string, cloneOptions *git.CloneOptions) error {
-	_, err := git.PlainClone(examplesLocation, false, cloneOptions)
-	return err
+		return framework.CloneRepository(data.KogitoExamplesLocation, config.GetExamplesRepositoryRef(), cloneConfig)
+	}, examplesCloneRetries, examplesCloneRetryDelay)
 }
@@ -54,12 +54,9 @@ func (data *Data) localServiceBuiltByMavenAndDeployedToRuntimeRegistry(contextDi
 
 // Build local service and deploy it to registry if the registry doesn't contain such image already
 func (data *Data) localServiceBuiltByMavenWithProfileAndDeployedToRuntimeRegistryWithMavenConfiguration(contextDir string, table *godog.Table) error {
-	mavenConfig := &mappers.MavenCommandConfig{}
-	if table != nil && len(table.Rows) > 0 {
-		err := mappers.MapMavenCommandConfigTable(table, mavenConfig)
-		if err != nil {
-			return err
-		}
+	mavenConfig, err := getMavenCommandConfig(table)
+	if err != nil {
+		return err
 	}
 
 	projectLocation := data.KogitoExamplesLocation + "/" + contextDir
@@ -15,6 +15,8 @@
 package steps
 
 import (
+	"strconv"
+
 	infinispan "github.com/infinispan/infinispan-operator/pkg/apis/infinispan/v1"
 
 	"github.com/cucumber/godog"
@@ -29,8 +31,22 @@ import (
 	| password | mypass    |
 */
 
+/*
+	DataTable for Infinispan instance configuration:
+	| uri               | external-infinispan:11222 |
+	| auth-secret       | external-infinispan-auth  |
+	| use-auth          | enabled                   |
+	| truststore-secret | external-infinispan-cert  |
+*/
+
 const (
 	externalInfinispanSecret = "external-infinispan-secret"
+
+	// Scenario context keys storing Infinispan instance configuration
+	infinispanURIContextKey              = "infinispan-uri"
+	infinispanAuthSecretContextKey       = "infinispan-auth-secret"
+	infinispanUseAuthContextKey          = "infinispan-use-auth"
+	infinispanTrustStoreSecretContextKey = "infinispan-truststore-secret"
 )
 
 var performanceInfinispanContainerSpec = infinispan.InfinispanContainerSpec{
@@ -45,6 +61,7 @@ func registerInfinispanSteps(ctx *godog.ScenarioContext, data *Data) {
 	ctx.Step(`^Infinispan instance "([^"]*)" is deployed with configuration:$`, data.infinispanInstanceIsDeployedWithConfiguration)
 	ctx.Step(`^Infinispan instance "([^"]*)" is deployed for performance within (\d+) minute\(s\) with configuration:$`, data.infinispanInstanceIsDeployedForPerformanceWithinMinutesWithConfiguration)
 	ctx.Step(`^Scale Infinispan instance "([^"]*)" to (\d+) pods within (\d+) minutes$`, data.scaleInfinispanInstanceToPodsWithinMinutes)
+	ctx.Step(`^Infinispan instance with configuration:$`, data.infinispanInstanceWithConfiguration)
 }
 
 func (data *Data) infinispanOperatorIsDeployed() error {
@@ -97,6 +114,19 @@ func (data *Data) scaleInfinispanInstanceToPodsWithinMinutes(name string, nbPods
 	return framework.WaitForPodsWithLabel(data.Namespace, "infinispan_cr", name, nbPods, timeoutInMin)
 }
 
+func (data *Data) infinispanInstanceWithConfiguration(table *godog.Table) error {
+	infinispanConfig := &mappers.InfinispanConfig{}
+	if err := mappers.MapInfinispanConfigTable(table, infinispanConfig); err != nil {
+		return err
+	}
+
+	data.ScenarioContext[infinispanURIContextKey] = infinispanConfig.URI
+	data.ScenarioContext[infinispanAuthSecretContextKey] = infinispanConfig.AuthSecret
+	data.ScenarioContext[infinispanUseAuthContextKey] = strconv.FormatBool(infinispanConfig.UseAuth)
+	data.ScenarioContext[infinispanTrustStoreSecretContextKey] = infinispanConfig.TrustStoreSecret
+	return nil
+}
+
 // Misc methods
 
 func createInfinispanSecret(namespace, secretName string, table *godog.Table) error {
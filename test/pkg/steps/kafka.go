@@ -15,14 +15,35 @@
 package steps
 
 import (
+	"fmt"
+	"strconv"
+
 	"github.com/cucumber/godog"
 	"github.com/kiegroup/kogito-operator/core/infrastructure"
 	"github.com/kiegroup/kogito-operator/core/infrastructure/kafka/v1beta2"
 	"github.com/kiegroup/kogito-operator/test/pkg/framework"
 	"github.com/kiegroup/kogito-operator/test/pkg/installers"
+	"github.com/kiegroup/kogito-operator/test/pkg/steps/mappers"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+const (
+	defaultKafkaTopicPartitions = 1
+	defaultKafkaTopicReplicas   = 1
+
+	kafkaTopicCreationTimeoutInMin = 5
+
+	kafkaTopicRetentionMsConfigKey = "retention.ms"
+)
+
+/*
+	DataTable for Kafka topic:
+	| name         | my-topic |
+	| partitions   | 3        |
+	| replicas     | 1        |
+	| retention-ms | 7200000  |
+*/
+
 func registerKafkaSteps(ctx *godog.ScenarioContext, data *Data) {
 	ctx.Step(`^Kafka Operator is deployed$`, data.kafkaOperatorIsDeployed)
 	ctx.Step(`^Kafka instance "([^"]*)" has (\d+) (?:pod|pods) running within (\d+) (?:minute|minutes)$`, data.kafkaInstanceHasPodsRunningWithinMinutes)
@@ -30,6 +51,13 @@ func registerKafkaSteps(ctx *godog.ScenarioContext, data *Data) {
 	ctx.Step(`^Kafka instance "([^"]*)" is deployed$`, data.kafkaInstanceIsDeployed)
 	ctx.Step(`^Scale Kafka instance "([^"]*)" down`, data.scaleKafkaInstanceDown)
 	ctx.Step(`^Kafka topic "([^"]*)" is deployed$`, data.kafkaTopicIsDeployed)
+	ctx.Step(`^Kafka topic is deployed with configuration:$`, data.kafkaTopicIsDeployedWithConfiguration)
+	ctx.Step(`^Kafka instance "([^"]*)" in namespace "([^"]*)" has a plain listener on port ([0-9]+)$`, data.kafkaInstanceInNamespaceHasPlainListenerOnPort)
+	ctx.Step(`^Kafka instance "([^"]*)" has ([0-9]+) replicas$`, data.kafkaInstanceHasReplicas)
+	ctx.Step(`^Kafka instance "([^"]*)" has ([0-9]+) ZooKeeper replicas$`, data.kafkaInstanceHasZookeeperReplicas)
+	ctx.Step(`^Kafka instance "([^"]*)" has ([0-9]+) listeners$`, data.kafkaInstanceHasListeners)
+	ctx.Step(`^Kafka topic "([^"]*)" exists in namespace "([^"]*)"$`, data.kafkaTopicExistsInNamespace)
+	ctx.Step(`^Kafka topic "([^"]*)" is deleted from namespace "([^"]*)" within ([0-9]+) (?:minute|minutes)$`, data.kafkaTopicIsDeletedFromNamespaceWithinMinutes)
 }
 
 func (data *Data) kafkaOperatorIsDeployed() error {
@@ -62,6 +90,68 @@ func (data *Data) kafkaTopicIsDeployed(name string) error {
 	return framework.DeployKafkaTopic(data.Namespace, name, infrastructure.KafkaInstanceName)
 }
 
+func (data *Data) kafkaTopicIsDeployedWithConfiguration(table *godog.Table) error {
+	topicConfig := &mappers.KafkaTopicConfig{
+		Partitions: defaultKafkaTopicPartitions,
+		Replicas:   defaultKafkaTopicReplicas,
+	}
+	if err := mappers.MapKafkaTopicConfigTable(table, topicConfig); err != nil {
+		return err
+	}
+
+	var kafkaConfig map[string]string
+	if topicConfig.RetentionMs > 0 {
+		kafkaConfig = map[string]string{kafkaTopicRetentionMsConfigKey: strconv.FormatInt(topicConfig.RetentionMs, 10)}
+	}
+
+	return framework.DeployKafkaTopicWithConfig(data.Namespace, topicConfig.TopicName, infrastructure.KafkaInstanceName, topicConfig.Partitions, topicConfig.Replicas, kafkaConfig)
+}
+
+func (data *Data) kafkaTopicExistsInNamespace(name, namespace string) error {
+	return framework.WaitForKafkaTopicCreated(data.ResolveWithScenarioContext(namespace), infrastructure.KafkaInstanceName, name, kafkaTopicCreationTimeoutInMin)
+}
+
+func (data *Data) kafkaTopicIsDeletedFromNamespaceWithinMinutes(name, namespace string, timeoutInMin int) error {
+	return framework.WaitForKafkaTopicDeleted(data.ResolveWithScenarioContext(namespace), name, timeoutInMin)
+}
+
+func (data *Data) kafkaInstanceInNamespaceHasPlainListenerOnPort(name, namespace string, port int) error {
+	return framework.VerifyKafkaListenerPort(data.ResolveWithScenarioContext(namespace), name, int32(port))
+}
+
+func (data *Data) kafkaInstanceHasReplicas(name string, expectedReplicas int) error {
+	replicas, err := framework.GetKafkaReplicaCount(data.Namespace, name)
+	if err != nil {
+		return err
+	}
+	if replicas != int32(expectedReplicas) {
+		return fmt.Errorf("Kafka instance %s has %d replicas, expected %d", name, replicas, expectedReplicas)
+	}
+	return nil
+}
+
+func (data *Data) kafkaInstanceHasZookeeperReplicas(name string, expectedReplicas int) error {
+	replicas, err := framework.GetKafkaZookeeperReplicas(data.Namespace, name)
+	if err != nil {
+		return err
+	}
+	if replicas != int32(expectedReplicas) {
+		return fmt.Errorf("Kafka instance %s has %d ZooKeeper replicas, expected %d", name, replicas, expectedReplicas)
+	}
+	return nil
+}
+
+func (data *Data) kafkaInstanceHasListeners(name string, expectedListeners int) error {
+	listeners, err := framework.GetKafkaListenerCount(data.Namespace, name)
+	if err != nil {
+		return err
+	}
+	if listeners != expectedListeners {
+		return fmt.Errorf("Kafka instance %s has %d listeners, expected %d", name, listeners, expectedListeners)
+	}
+	return nil
+}
+
 func getKafkaDefaultResource(name, namespace string) *v1beta2.Kafka {
 	return &v1beta2.Kafka{
 		ObjectMeta: metav1.ObjectMeta{
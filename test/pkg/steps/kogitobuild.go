@@ -28,6 +28,11 @@ import (
 	bddtypes "github.com/kiegroup/kogito-operator/test/pkg/types"
 )
 
+const (
+	// kogitoBuildDeployedContextKey is set in the scenario context once a KogitoBuild is deployed
+	kogitoBuildDeployedContextKey = "kogito-build-deployed"
+)
+
 /*
 	DataTable for KogitoBuild:
 	| config        | native     | enabled/disabled |
@@ -40,6 +45,9 @@ func registerKogitoBuildSteps(ctx *godog.ScenarioContext, data *Data) {
 	ctx.Step(`^Build (quarkus|springboot) example service "([^"]*)" with configuration:$`, data.buildExampleServiceWithConfiguration)
 	ctx.Step(`^Build binary (quarkus|springboot) service "([^"]*)" with configuration:$`, data.buildBinaryServiceWithConfiguration)
 	ctx.Step(`^Build binary (quarkus|springboot) local example service "([^"]*)" from target folder with configuration:$`, data.buildBinaryLocalExampleServiceFromTargetFolderWithConfiguration)
+
+	// Build status steps
+	ctx.Step(`^KogitoBuild "([^"]*)" in namespace "([^"]*)" is complete within (\d+) minutes$`, data.kogitoBuildInNamespaceIsCompleteWithinMinutes)
 }
 
 // Build service steps
@@ -57,7 +65,7 @@ func (data *Data) buildExampleServiceWithConfiguration(runtimeType, contextDir s
 		buildHolder.KogitoBuild.GetSpec().GetGitSource().SetReference(ref)
 	}
 
-	return framework.DeployKogitoBuild(data.Namespace, framework.GetDefaultInstallerType(), buildHolder)
+	return data.deployKogitoBuild(data.Namespace, buildHolder)
 }
 
 func (data *Data) buildBinaryServiceWithConfiguration(runtimeType, serviceName string, table *godog.Table) error {
@@ -68,7 +76,7 @@ func (data *Data) buildBinaryServiceWithConfiguration(runtimeType, serviceName s
 
 	buildHolder.KogitoBuild.GetSpec().SetType(api.BinaryBuildType)
 
-	return framework.DeployKogitoBuild(data.Namespace, framework.GetDefaultInstallerType(), buildHolder)
+	return data.deployKogitoBuild(data.Namespace, buildHolder)
 }
 
 func (data *Data) buildBinaryLocalExampleServiceFromTargetFolderWithConfiguration(runtimeType, serviceName string, table *godog.Table) error {
@@ -80,7 +88,7 @@ func (data *Data) buildBinaryLocalExampleServiceFromTargetFolderWithConfiguratio
 	buildHolder.KogitoBuild.GetSpec().SetType(api.BinaryBuildType)
 	buildHolder.BuiltBinaryFolder = fmt.Sprintf(`%s/%s/target`, data.KogitoExamplesLocation, serviceName)
 
-	err = framework.DeployKogitoBuild(data.Namespace, framework.GetDefaultInstallerType(), buildHolder)
+	err = data.deployKogitoBuild(data.Namespace, buildHolder)
 	if err != nil {
 		return err
 	}
@@ -97,8 +105,20 @@ func (data *Data) buildBinaryLocalExampleServiceFromTargetFolderWithConfiguratio
 	return nil
 }
 
+// Build status steps
+
+func (data *Data) kogitoBuildInNamespaceIsCompleteWithinMinutes(buildName, namespace string, timeoutInMin int) error {
+	return framework.WaitForKogitoBuildComplete(namespace, buildName, timeoutInMin)
+}
+
 // Misc methods
 
+// deployKogitoBuild deploys the KogitoBuild and flags the scenario, so that build failure reasons are logged if the scenario fails
+func (data *Data) deployKogitoBuild(namespace string, buildHolder *bddtypes.KogitoBuildHolder) error {
+	data.ScenarioContext[kogitoBuildDeployedContextKey] = "true"
+	return framework.DeployKogitoBuild(namespace, framework.GetDefaultInstallerType(), buildHolder)
+}
+
 // getKogitoBuildConfiguredStub Get KogitoBuildHolder initialized from table if provided
 func getKogitoBuildConfiguredStub(namespace, runtimeType, serviceName string, table *godog.Table) (buildHolder *types.KogitoBuildHolder, err error) {
 	kogitoBuild := framework.GetKogitoBuildStub(namespace, runtimeType, serviceName)
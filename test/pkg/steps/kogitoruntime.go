@@ -15,6 +15,8 @@
 package steps
 
 import (
+	"fmt"
+
 	"github.com/cucumber/godog"
 	"github.com/kiegroup/kogito-operator/api"
 	framework2 "github.com/kiegroup/kogito-operator/core/framework"
@@ -48,6 +50,7 @@ func registerKogitoRuntimeSteps(ctx *godog.ScenarioContext, data *Data) {
 
 	// Kogito Runtime steps
 	ctx.Step(`^Scale Kogito Runtime "([^"]*)" to (\d+) pods within (\d+) minutes$`, data.scaleKogitoRuntimeToPodsWithinMinutes)
+	ctx.Step(`^there are ([0-9]+) Kogito Runtime services in namespace "([^"]*)"$`, data.thereAreKogitoRuntimeServicesInNamespace)
 
 	// Logging steps
 	ctx.Step(`^Kogito Runtime "([^"]*)" log contains text "([^"]*)" within (\d+) minutes$`, data.kogitoRuntimeLogContainsTextWithinMinutes)
@@ -97,6 +100,18 @@ func (data *Data) scaleKogitoRuntimeToPodsWithinMinutes(name string, nbPods, tim
 	return framework.WaitForDeploymentRunning(data.Namespace, name, nbPods, timeoutInMin)
 }
 
+func (data *Data) thereAreKogitoRuntimeServicesInNamespace(expectedCount int, namespace string) error {
+	namespace = data.ResolveWithScenarioContext(namespace)
+	kogitoRuntimes, err := framework.ListKogitoRuntimeServices(namespace)
+	if err != nil {
+		return err
+	}
+	if len(kogitoRuntimes) != expectedCount {
+		return fmt.Errorf("Found %d Kogito Runtime services in namespace %s, expected %d", len(kogitoRuntimes), namespace, expectedCount)
+	}
+	return nil
+}
+
 // Logging steps
 func (data *Data) kogitoRuntimeLogContainsTextWithinMinutes(dName, logText string, timeoutInMin int) error {
 	return framework.WaitForAllPodsByDeploymentToContainTextInLog(data.Namespace, dName, logText, timeoutInMin)
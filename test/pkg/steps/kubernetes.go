@@ -15,6 +15,9 @@
 package steps
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/cucumber/godog"
 	"github.com/kiegroup/kogito-operator/test/pkg/framework"
 	"github.com/kiegroup/kogito-operator/test/pkg/steps/mappers"
@@ -30,18 +33,24 @@ import (
 func registerKubernetesSteps(ctx *godog.ScenarioContext, data *Data) {
 	ctx.Step(`^Namespace is created$`, data.namespaceIsCreated)
 	ctx.Step(`^Namespace is deleted$`, data.namespaceIsDeleted)
+	ctx.Step(`^Namespace "([^"]*)" has label "([^"]*)" with value "([^"]*)"$`, data.namespaceHasLabelWithValue)
+	ctx.Step(`^Namespace "([^"]*)" has annotation "([^"]*)" with value "([^"]*)"$`, data.namespaceHasAnnotationWithValue)
 
 	ctx.Step(`^CLI create namespace$`, data.cliCreateNamespace)
 	ctx.Step(`^CLI use namespace$`, data.cliUseNamespace)
 
 	ctx.Step(`^Deployment "([^"]*)" has (\d+) pods with runtime resources within (\d+) minutes:$`, data.deploymentHasResourcesWithinMinutes)
+	ctx.Step(`^there are ([0-9]+) "([^"]*)" resources in namespace "([^"]*)"$`, data.thereAreResourcesInNamespace)
 
 	// Logging steps
 	ctx.Step(`^Deployment "([^"]*)" pods log contains text "([^"]*)" within (\d+) minutes$`, data.deploymentPodsLogContainsTextWithinMinutes)
 }
 
 func (data *Data) namespaceIsCreated() error {
-	return framework.CreateNamespace(data.Namespace)
+	if err := framework.EnsureNamespaceExists(data.Namespace); err != nil {
+		return err
+	}
+	return framework.CheckNamespaceResourceQuotaCompliance(data.Namespace)
 }
 
 func (data *Data) namespaceIsDeleted() error {
@@ -65,6 +74,34 @@ func (data *Data) namespaceIsDeleted() error {
 	return nil
 }
 
+func (data *Data) namespaceHasLabelWithValue(namespace, label, expectedValue string) error {
+	namespace = data.ResolveWithScenarioContext(namespace)
+	labels, err := framework.FetchNamespaceLabels(namespace)
+	if err != nil {
+		return err
+	}
+	if value, found := labels[label]; !found {
+		return fmt.Errorf("Namespace %s doesn't have label %s", namespace, label)
+	} else if value != expectedValue {
+		return fmt.Errorf("Namespace %s has label %s with value %s, expected %s", namespace, label, value, expectedValue)
+	}
+	return nil
+}
+
+func (data *Data) namespaceHasAnnotationWithValue(namespace, annotation, expectedValue string) error {
+	namespace = data.ResolveWithScenarioContext(namespace)
+	annotations, err := framework.FetchNamespaceAnnotations(namespace)
+	if err != nil {
+		return err
+	}
+	if value, found := annotations[annotation]; !found {
+		return fmt.Errorf("Namespace %s doesn't have annotation %s", namespace, annotation)
+	} else if value != expectedValue {
+		return fmt.Errorf("Namespace %s has annotation %s with value %s, expected %s", namespace, annotation, value, expectedValue)
+	}
+	return nil
+}
+
 func (data *Data) cliCreateNamespace() error {
 	_, err := framework.ExecuteCliCommand(data.Namespace, "new-project", data.Namespace)
 	return err
@@ -90,6 +127,27 @@ func (data *Data) deploymentHasResourcesWithinMinutes(dName string, podNb, timeo
 	return framework.WaitForPodsByDeploymentToHaveResources(data.Namespace, dName, *runtime, timeoutInMin)
 }
 
+func (data *Data) thereAreResourcesInNamespace(expectedCount int, resourceKind, namespace string) error {
+	kind, group := parseResourceKindAndGroup(resourceKind)
+	namespace = data.ResolveWithScenarioContext(namespace)
+	count, err := framework.GetNamespacedResourceCount(namespace, kind, group)
+	if err != nil {
+		return err
+	}
+	if count != expectedCount {
+		return fmt.Errorf("Found %d %s resources in namespace %s, expected %d", count, resourceKind, namespace, expectedCount)
+	}
+	return nil
+}
+
 func (data *Data) deploymentPodsLogContainsTextWithinMinutes(dName, logText string, timeoutInMin int) error {
 	return framework.WaitForAllPodsByDeploymentToContainTextInLog(data.Namespace, dName, logText, timeoutInMin)
 }
+
+// parseResourceKindAndGroup splits a resource reference in the form Kind.group, core API group is used if no group is given
+func parseResourceKindAndGroup(resourceKind string) (kind, group string) {
+	if index := strings.Index(resourceKind, "."); index >= 0 {
+		return resourceKind[:index], resourceKind[index+1:]
+	}
+	return resourceKind, ""
+}
@@ -26,8 +26,21 @@ const (
 	// DataTable first column
 	infinispanUsernameKey = "username"
 	infinispanPasswordKey = "password"
+
+	infinispanURIKey              = "uri"
+	infinispanAuthSecretKey       = "auth-secret"
+	infinispanUseAuthKey          = "use-auth"
+	infinispanTrustStoreSecretKey = "truststore-secret"
 )
 
+// InfinispanConfig contains configuration of an Infinispan instance, taken from configuration table
+type InfinispanConfig struct {
+	URI              string
+	AuthSecret       string
+	UseAuth          bool
+	TrustStoreSecret string
+}
+
 // MapInfinispanCredentialsFromTable maps Cucumber table to Infinispan credentials
 func MapInfinispanCredentialsFromTable(table *godog.Table) (username, password string, err error) {
 	if len(table.Rows) == 0 { // Using default configuration
@@ -52,3 +65,32 @@ func MapInfinispanCredentialsFromTable(table *godog.Table) (username, password s
 	}
 	return
 }
+
+// MapInfinispanConfigTable maps Cucumber table to Infinispan configuration
+func MapInfinispanConfigTable(table *godog.Table, cfg *InfinispanConfig) error {
+	if len(table.Rows) == 0 { // Using default configuration
+		return nil
+	}
+
+	if len(table.Rows[0].Cells) != 2 {
+		return fmt.Errorf("expected table to have exactly two columns")
+	}
+
+	for _, row := range table.Rows {
+		firstColumn := GetFirstColumn(row)
+		switch firstColumn {
+		case infinispanURIKey:
+			cfg.URI = GetSecondColumn(row)
+		case infinispanAuthSecretKey:
+			cfg.AuthSecret = GetSecondColumn(row)
+		case infinispanUseAuthKey:
+			cfg.UseAuth = MustParseEnabledDisabled(GetSecondColumn(row))
+		case infinispanTrustStoreSecretKey:
+			cfg.TrustStoreSecret = GetSecondColumn(row)
+
+		default:
+			return fmt.Errorf("Unrecognized configuration option: %s", firstColumn)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mappers
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cucumber/godog"
+)
+
+// *** Whenever you add new parsing functionality here please add corresponding DataTable example to every file in steps which can use the functionality ***
+
+const (
+	// DataTable first column
+	kafkaTopicNameKey        = "name"
+	kafkaTopicPartitionsKey  = "partitions"
+	kafkaTopicReplicasKey    = "replicas"
+	kafkaTopicRetentionMsKey = "retention-ms"
+)
+
+// KafkaTopicConfig contains configuration of a Kafka topic, taken from configuration table
+type KafkaTopicConfig struct {
+	TopicName   string
+	Partitions  int32
+	Replicas    int32
+	RetentionMs int64
+}
+
+// MapKafkaTopicConfigTable maps Cucumber table to Kafka topic configuration
+func MapKafkaTopicConfigTable(table *godog.Table, cfg *KafkaTopicConfig) error {
+	if len(table.Rows) == 0 { // Using default configuration
+		return nil
+	}
+
+	if len(table.Rows[0].Cells) != 2 {
+		return fmt.Errorf("expected table to have exactly two columns")
+	}
+
+	for _, row := range table.Rows {
+		firstColumn := GetFirstColumn(row)
+		switch firstColumn {
+		case kafkaTopicNameKey:
+			cfg.TopicName = GetSecondColumn(row)
+		case kafkaTopicPartitionsKey:
+			partitions, err := strconv.ParseInt(GetSecondColumn(row), 10, 32)
+			if err != nil {
+				return fmt.Errorf("Error parsing Kafka topic partitions: %v", err)
+			}
+			cfg.Partitions = int32(partitions)
+		case kafkaTopicReplicasKey:
+			replicas, err := strconv.ParseInt(GetSecondColumn(row), 10, 32)
+			if err != nil {
+				return fmt.Errorf("Error parsing Kafka topic replicas: %v", err)
+			}
+			cfg.Replicas = int32(replicas)
+		case kafkaTopicRetentionMsKey:
+			retentionMs, err := strconv.ParseInt(GetSecondColumn(row), 10, 64)
+			if err != nil {
+				return fmt.Errorf("Error parsing Kafka topic retention: %v", err)
+			}
+			cfg.RetentionMs = retentionMs
+
+		default:
+			return fmt.Errorf("Unrecognized configuration option: %s", firstColumn)
+		}
+	}
+	return nil
+}
@@ -23,9 +23,14 @@ import (
 
 const (
 	// Maven config first column
-	mavenProfileKey = "profile"
-	mavenOptionKey  = "option"
-	mavenNativeKey  = "native"
+	mavenProfileKey         = "profile"
+	mavenOptionKey          = "option"
+	mavenNativeKey          = "native"
+	mavenLocalRepositoryKey = "local-repository"
+	mavenOfflineKey         = "offline"
+
+	// mavenTestSkipProperty skips both compilation and execution of tests, unlike -DskipTests which skips only their execution
+	mavenTestSkipProperty = "maven.test.skip"
 )
 
 // MavenCommandConfig contains configuration for Maven Command execution
@@ -33,6 +38,122 @@ type MavenCommandConfig struct {
 	Profiles []string
 	Options  []string
 	Native   bool
+	// LocalRepository is the path of the local Maven repository, nil means default `~/.m2` repository is used
+	LocalRepository *string
+	// SystemProperties are passed to the Maven command as -Dkey=value arguments, in the order they were first added
+	SystemProperties []MavenSystemProperty
+	// Repositories are added to the settings.xml used by the Maven command
+	Repositories []MavenRepository
+	// Offline runs the Maven command without accessing remote repositories (-o)
+	Offline bool
+	// UpdateSnapshots forces the update of snapshot artifacts from remote repositories (-U)
+	UpdateSnapshots bool
+}
+
+// MavenRepository is an artifact repository used by the Maven command
+type MavenRepository struct {
+	ID  string
+	URL string
+}
+
+// MavenSystemProperty is a system property passed to the Maven command
+type MavenSystemProperty struct {
+	Key   string
+	Value string
+}
+
+// WithProfiles adds the profiles to activate in the Maven command, already added profiles are ignored
+func (config *MavenCommandConfig) WithProfiles(profiles ...string) *MavenCommandConfig {
+	for _, profile := range profiles {
+		profile = strings.TrimSpace(profile)
+		if len(profile) > 0 && !containsProfile(config.Profiles, profile) {
+			config.Profiles = append(config.Profiles, profile)
+		}
+	}
+	return config
+}
+
+// GetProfilesArgument returns the -P argument activating all configured profiles, empty if there is no profile
+func (config *MavenCommandConfig) GetProfilesArgument() string {
+	if len(config.Profiles) == 0 {
+		return ""
+	}
+	return "-P" + strings.Join(config.Profiles, ",")
+}
+
+func containsProfile(profiles []string, profile string) bool {
+	for _, existingProfile := range profiles {
+		if existingProfile == profile {
+			return true
+		}
+	}
+	return false
+}
+
+// WithLocalRepository sets the local Maven repository used by the Maven command to isolate Maven caches.
+// Empty path means that a temporary repository unique for the scenario is used.
+func (config *MavenCommandConfig) WithLocalRepository(path string) *MavenCommandConfig {
+	config.LocalRepository = &path
+	return config
+}
+
+// WithSystemProperty sets a system property passed to the Maven command, overwriting the value of an already set property with the same key
+func (config *MavenCommandConfig) WithSystemProperty(key, value string) *MavenCommandConfig {
+	for i := range config.SystemProperties {
+		if config.SystemProperties[i].Key == key {
+			config.SystemProperties[i].Value = value
+			return config
+		}
+	}
+	config.SystemProperties = append(config.SystemProperties, MavenSystemProperty{Key: key, Value: value})
+	return config
+}
+
+// SkipTestCompilation skips compilation of test sources using -Dmaven.test.skip=true.
+// Unlike -DskipTests, which only skips test execution, test sources are neither compiled nor executed.
+func (config *MavenCommandConfig) SkipTestCompilation() *MavenCommandConfig {
+	return config.WithSystemProperty(mavenTestSkipProperty, "true")
+}
+
+// GetSystemPropertiesArguments returns the -D arguments setting all configured system properties
+func (config *MavenCommandConfig) GetSystemPropertiesArguments() []string {
+	var arguments []string
+	for _, property := range config.SystemProperties {
+		arguments = append(arguments, fmt.Sprintf("-D%s=%s", property.Key, property.Value))
+	}
+	return arguments
+}
+
+// WithRepositoryURL adds an artifact repository to the settings used by the Maven command, overwriting the URL of an already added repository with the same ID
+func (config *MavenCommandConfig) WithRepositoryURL(id, url string) *MavenCommandConfig {
+	for i := range config.Repositories {
+		if config.Repositories[i].ID == id {
+			config.Repositories[i].URL = url
+			return config
+		}
+	}
+	config.Repositories = append(config.Repositories, MavenRepository{ID: id, URL: url})
+	return config
+}
+
+// WithOfflineMode runs the Maven command in offline mode, can't be combined with WithUpdateSnapshots
+func (config *MavenCommandConfig) WithOfflineMode() *MavenCommandConfig {
+	config.Offline = true
+	return config
+}
+
+// WithUpdateSnapshots forces the update of snapshot artifacts, can't be combined with WithOfflineMode
+func (config *MavenCommandConfig) WithUpdateSnapshots() *MavenCommandConfig {
+	config.UpdateSnapshots = true
+	return config
+}
+
+// Validate returns an error if the configuration contains mutually exclusive settings
+func (config *MavenCommandConfig) Validate() error {
+	if config.Offline && config.UpdateSnapshots {
+		return fmt.Errorf("Maven offline mode can't be combined with update of snapshots")
+	}
+	return nil
 }
 
 // MapMavenCommandConfigTable maps Cucumber table with Maven options to a slice
@@ -49,11 +170,17 @@ func MapMavenCommandConfigTable(table *godog.Table, config *MavenCommandConfig)
 		firstColumn := GetFirstColumn(row)
 		switch firstColumn {
 		case mavenProfileKey:
-			config.Profiles = append(config.Profiles, strings.Split(GetSecondColumn(row), ",")...)
+			config.WithProfiles(strings.Split(GetSecondColumn(row), ",")...)
 		case mavenOptionKey:
 			config.Options = append(config.Options, GetSecondColumn(row))
 		case mavenNativeKey:
 			config.Native = MustParseEnabledDisabled(GetSecondColumn(row))
+		case mavenLocalRepositoryKey:
+			config.WithLocalRepository(GetSecondColumn(row))
+		case mavenOfflineKey:
+			if MustParseEnabledDisabled(GetSecondColumn(row)) {
+				config.WithOfflineMode()
+			}
 		default:
 			return fmt.Errorf("Unrecognized configuration option: %s", firstColumn)
 		}
@@ -0,0 +1,100 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mappers
+
+import (
+	"testing"
+
+	"github.com/cucumber/godog"
+	"github.com/cucumber/messages-go/v10"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMavenCommandConfig_WithProfiles(t *testing.T) {
+	config := &MavenCommandConfig{}
+	config.WithProfiles("profile1", "profile2").WithProfiles("profile2", " profile3 ", "")
+
+	assert.Equal(t, []string{"profile1", "profile2", "profile3"}, config.Profiles)
+	assert.Equal(t, "-Pprofile1,profile2,profile3", config.GetProfilesArgument())
+}
+
+func TestMavenCommandConfig_GetProfilesArgumentWithoutProfiles(t *testing.T) {
+	config := &MavenCommandConfig{}
+
+	assert.Empty(t, config.GetProfilesArgument())
+}
+
+func TestMapMavenCommandConfigTable_MergesProfiles(t *testing.T) {
+	table := &godog.Table{
+		Rows: []*TableRow{
+			newTableRow(mavenProfileKey, "profile1,profile2"),
+			newTableRow(mavenProfileKey, "profile2"),
+		},
+	}
+	config := (&MavenCommandConfig{}).WithProfiles("profile0")
+
+	err := MapMavenCommandConfigTable(table, config)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "-Pprofile0,profile1,profile2", config.GetProfilesArgument())
+}
+
+func TestMavenCommandConfig_WithSystemProperty(t *testing.T) {
+	config := &MavenCommandConfig{}
+	config.WithSystemProperty("quarkus.profile", "dev").
+		WithSystemProperty("skipITs", "true").
+		WithSystemProperty("quarkus.profile", "prod")
+
+	assert.Equal(t, []string{"-Dquarkus.profile=prod", "-DskipITs=true"}, config.GetSystemPropertiesArguments())
+}
+
+func TestMavenCommandConfig_GetSystemPropertiesArgumentsWithoutProperties(t *testing.T) {
+	config := &MavenCommandConfig{}
+
+	assert.Empty(t, config.GetSystemPropertiesArguments())
+}
+
+func TestMavenCommandConfig_SkipTestCompilation(t *testing.T) {
+	config := &MavenCommandConfig{}
+	config.SkipTestCompilation().SkipTestCompilation()
+
+	assert.Equal(t, []string{"-Dmaven.test.skip=true"}, config.GetSystemPropertiesArguments())
+}
+
+func TestMavenCommandConfig_WithRepositoryURL(t *testing.T) {
+	config := &MavenCommandConfig{}
+	config.WithRepositoryURL("custom", "https://repo1.example.com/maven").
+		WithRepositoryURL("snapshots", "https://snapshots.example.com/maven").
+		WithRepositoryURL("custom", "https://repo2.example.com/maven")
+
+	assert.Equal(t, []MavenRepository{
+		{ID: "custom", URL: "https://repo2.example.com/maven"},
+		{ID: "snapshots", URL: "https://snapshots.example.com/maven"},
+	}, config.Repositories)
+}
+
+func TestMavenCommandConfig_Validate(t *testing.T) {
+	assert.NoError(t, (&MavenCommandConfig{}).WithOfflineMode().Validate())
+	assert.NoError(t, (&MavenCommandConfig{}).WithUpdateSnapshots().Validate())
+	assert.Error(t, (&MavenCommandConfig{}).WithOfflineMode().WithUpdateSnapshots().Validate())
+}
+
+func newTableRow(values ...string) *TableRow {
+	row := &TableRow{}
+	for _, value := range values {
+		row.Cells = append(row.Cells, &messages.PickleStepArgument_PickleTable_PickleTableRow_PickleTableCell{Value: value})
+	}
+	return row
+}
@@ -15,6 +15,10 @@
 package steps
 
 import (
+	"fmt"
+	"os"
+	"path/filepath"
+
 	"github.com/cucumber/godog"
 	"github.com/kiegroup/kogito-operator/test/pkg/framework"
 	"github.com/kiegroup/kogito-operator/test/pkg/steps/mappers"
@@ -22,23 +26,28 @@ import (
 
 /*
 	DataTable for Maven:
-	| profile | profile        |
-	| profile | profile2       |
-	| option  | -Doption=true  |
-	| option  | -Doption2=true |
-	| native  | enabled        |
+	| profile          | profile        |
+	| profile          | profile2       |
+	| option           | -Doption=true  |
+	| option           | -Doption2=true |
+	| native           | enabled        |
+	| offline          | enabled        |
+	| local-repository | /tmp/m2        |
 */
 
 const (
 	// DefaultMavenBuiltExampleRegex is the regex for building maven example
 	DefaultMavenBuiltExampleRegex = "Local example service \"([^\"]*)\" is built by Maven"
 	nativeProfile                 = "native"
+	mavenLocalRepositoryPrefix    = "kogito-maven-repository-"
 )
 
 // registerMavenSteps register all existing Maven steps
 func registerMavenSteps(ctx *godog.ScenarioContext, data *Data) {
 	ctx.Step("^"+DefaultMavenBuiltExampleRegex+"$", data.localServiceBuiltByMaven)
 	ctx.Step("^"+DefaultMavenBuiltExampleRegex+" with configuration:$", data.localServiceBuiltByMavenWithConfiguration)
+
+	ctx.Step(`^project "([^"]*)" has version "([^"]*)"$`, data.projectHasVersion)
 }
 
 // Build local service
@@ -48,30 +57,77 @@ func (data *Data) localServiceBuiltByMaven(serviceName string) error {
 
 // Build local service with configuration
 func (data *Data) localServiceBuiltByMavenWithConfiguration(serviceName string, table *godog.Table) error {
-	mavenConfig := &mappers.MavenCommandConfig{}
-	if table != nil && len(table.Rows) > 0 {
-		err := mappers.MapMavenCommandConfigTable(table, mavenConfig)
-		if err != nil {
-			return err
-		}
+	mavenConfig, err := getMavenCommandConfig(table)
+	if err != nil {
+		return err
 	}
 	return data.localServiceBuiltByMavenWithProfileAndOptions(serviceName, mavenConfig)
 }
 
 // Build local service with profile and additional options
 func (data *Data) localServiceBuiltByMavenWithProfileAndOptions(serviceName string, mavenConfig *mappers.MavenCommandConfig) error {
+	if err := mavenConfig.Validate(); err != nil {
+		return err
+	}
+
 	serviceRepositoryPath := data.KogitoExamplesLocation + "/" + serviceName
 	mvnCmd := framework.CreateMavenCommand(serviceRepositoryPath).
 		SkipTests().
-		UpdateArtifacts().
 		Options(mavenConfig.Options...).
+		Options(mavenConfig.GetSystemPropertiesArguments()...).
 		Profiles(mavenConfig.Profiles...).
 		WithLoggerContext(data.Namespace)
 
+	if mavenConfig.UpdateSnapshots {
+		mvnCmd = mvnCmd.UpdateArtifacts()
+	}
+	if mavenConfig.Offline {
+		mvnCmd = mvnCmd.Options("-o")
+	}
 	if mavenConfig.Native {
 		mvnCmd = mvnCmd.Profiles(nativeProfile)
 	}
+	for _, repo := range mavenConfig.Repositories {
+		mvnCmd = mvnCmd.Repository(repo.ID, repo.URL)
+	}
+	if mavenConfig.LocalRepository != nil {
+		mvnCmd = mvnCmd.Options(fmt.Sprintf("-Dmaven.repo.local=%s", data.getMavenLocalRepository(*mavenConfig.LocalRepository)))
+	}
 	output, err := mvnCmd.Execute("clean", "package")
 	framework.GetLogger(data.Namespace).Debug(output)
 	return err
 }
+
+// Returns the Maven configuration mapped from the table, snapshots are updated unless offline mode is requested
+func getMavenCommandConfig(table *godog.Table) (*mappers.MavenCommandConfig, error) {
+	mavenConfig := &mappers.MavenCommandConfig{}
+	if table != nil && len(table.Rows) > 0 {
+		if err := mappers.MapMavenCommandConfigTable(table, mavenConfig); err != nil {
+			return nil, err
+		}
+	}
+	if !mavenConfig.Offline {
+		mavenConfig.WithUpdateSnapshots()
+	}
+	return mavenConfig, nil
+}
+
+// Check the version of local example project
+func (data *Data) projectHasVersion(projectName, expectedVersion string) error {
+	version, err := framework.ReadMavenProjectVersion(filepath.Join(data.KogitoExamplesLocation, projectName, "pom.xml"))
+	if err != nil {
+		return err
+	}
+	if version != expectedVersion {
+		return fmt.Errorf("Project %s has version %s, expected %s", projectName, version, expectedVersion)
+	}
+	return nil
+}
+
+// Returns the local Maven repository path, defaulting to a temporary directory unique for the scenario
+func (data *Data) getMavenLocalRepository(path string) string {
+	if len(path) > 0 {
+		return path
+	}
+	return filepath.Join(os.TempDir(), mavenLocalRepositoryPrefix+data.Namespace)
+}
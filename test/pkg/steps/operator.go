@@ -15,16 +15,35 @@
 package steps
 
 import (
+	"fmt"
+
 	"github.com/cucumber/godog"
+	"github.com/kiegroup/kogito-operator/test/pkg/config"
 	"github.com/kiegroup/kogito-operator/test/pkg/framework"
 	"github.com/kiegroup/kogito-operator/test/pkg/installers"
 )
 
+const (
+	// operatorInstallationTimeoutInMin timeout for operators installed at a specific version
+	operatorInstallationTimeoutInMin = 10
+	// kogitoOperatorDependenciesTimeoutInMin timeout for Kogito operator dependencies installed together
+	kogitoOperatorDependenciesTimeoutInMin = 10
+)
+
 func registerOperatorSteps(ctx *godog.ScenarioContext, data *Data) {
 	ctx.Step(`^Kogito operator should be installed$`, data.kogitoOperatorShouldBeInstalled)
 	ctx.Step(`^Kogito Operator is deployed$`, data.kogitoOperatorIsDeployed)
+	ctx.Step(`^Infinispan, Kafka and Keycloak Operators are deployed$`, data.infinispanKafkaAndKeycloakOperatorsAreDeployed)
 
 	ctx.Step(`^CLI install Kogito operator$`, data.cliInstallKogitoOperator)
+
+	ctx.Step(`^I install operator "([^"]*)" at version "([^"]*)" from catalog "([^"]*)"$`, data.iInstallOperatorAtVersionFromCatalog)
+	ctx.Step(`^the Kogito operator version matches "([^"]*)"$`, data.theKogitoOperatorVersionMatches)
+	ctx.Step(`^the operator "([^"]*)" version is "([^"]*)"$`, data.theOperatorVersionIs)
+	ctx.Step(`^the Kogito operator has an active leader in namespace "([^"]*)"$`, data.theKogitoOperatorHasAnActiveLeaderInNamespace)
+	ctx.Step(`^the Kogito operator pod is on node "([^"]*)"$`, data.theKogitoOperatorPodIsOnNode)
+	ctx.Step(`^operator "([^"]*)" owns CRD "([^"]*)"$`, data.operatorOwnsCRD)
+	ctx.Step(`^operator pod has image tag "([^"]*)"$`, data.operatorPodHasImageTag)
 }
 
 func (data *Data) kogitoOperatorShouldBeInstalled() error {
@@ -39,10 +58,121 @@ func (data *Data) kogitoOperatorIsDeployed() error {
 	if err := installer.Install(data.Namespace); err != nil {
 		return err
 	}
-	return nil
+	// Created ahead of metrics scraping so that its permissions have time to propagate
+	return framework.CreateKogitoOperatorMetricsReader(data.Namespace)
+}
+
+func (data *Data) infinispanKafkaAndKeycloakOperatorsAreDeployed() error {
+	infinispanInstaller, err := installers.GetInfinispanInstaller()
+	if err != nil {
+		return err
+	}
+
+	// Operators are only created here, they are then awaited together
+	for _, installer := range []installers.ServiceInstaller{infinispanInstaller, installers.GetKafkaInstaller(), installers.GetKeycloakInstaller()} {
+		if err := installer.InstallWithoutWaiting(data.Namespace); err != nil {
+			return err
+		}
+	}
+	return framework.WaitForAllKogitoOperatorDependenciesRunning(data.Namespace, kogitoOperatorDependenciesTimeoutInMin)
 }
 
 func (data *Data) cliInstallKogitoOperator() error {
 	_, err := framework.ExecuteCliCommandInNamespace(data.Namespace, "install", "operator")
 	return err
 }
+
+func (data *Data) iInstallOperatorAtVersionFromCatalog(operatorName, version, catalogName string) error {
+	catalog := framework.GetOperatorCatalog(catalogName)
+	// Operator CSVs are named by OLM convention as <package>.v<version>
+	options := framework.SubscriptionOptions{StartingCSV: fmt.Sprintf("%s.v%s", operatorName, version)}
+	// Empty channel means the default channel of the package is used
+	if err := framework.InstallOperatorWithOptions(data.Namespace, operatorName, "", catalog, options); err != nil {
+		return err
+	}
+	return framework.WaitForOperatorRunning(data.Namespace, operatorName, catalog, operatorInstallationTimeoutInMin)
+}
+
+func (data *Data) theKogitoOperatorVersionMatches(expectedVersion string) error {
+	version, err := framework.GetKogitoOperatorVersion(data.getKogitoOperatorNamespace())
+	if err != nil {
+		return err
+	}
+	if version != expectedVersion {
+		return fmt.Errorf("Kogito operator has version %s, expected %s", version, expectedVersion)
+	}
+	return nil
+}
+
+func (data *Data) theOperatorVersionIs(operatorName, expectedVersion string) error {
+	// Subscriptions created by the framework are named after the operator package
+	version, err := framework.GetSubscriptionInstalledVersion(data.Namespace, operatorName)
+	if err != nil {
+		return err
+	}
+	if version != expectedVersion {
+		return fmt.Errorf("Operator %s has version %s, expected %s", operatorName, version, expectedVersion)
+	}
+	return nil
+}
+
+func (data *Data) theKogitoOperatorHasAnActiveLeaderInNamespace(namespace string) error {
+	namespace = data.ResolveWithScenarioContext(namespace)
+	leader, err := framework.CheckKogitoOperatorLeaderElection(namespace)
+	if err != nil {
+		return err
+	}
+	framework.GetLogger(namespace).Info("Kogito operator leader found", "leader", leader)
+	return nil
+}
+
+func (data *Data) theKogitoOperatorPodIsOnNode(expectedNodeName string) error {
+	nodeName, err := framework.GetOperatorPodNodeName(data.getKogitoOperatorNamespace())
+	if err != nil {
+		return err
+	}
+	if nodeName != expectedNodeName {
+		return fmt.Errorf("Kogito operator pod is scheduled on node %s, expected %s", nodeName, expectedNodeName)
+	}
+	return nil
+}
+
+func (data *Data) operatorOwnsCRD(operatorName, crdName string) error {
+	namespace, catalog := data.Namespace, framework.CommunityCatalog
+	// Kogito operator is installed from the custom catalog built for BDD tests
+	if operatorName == framework.GetKogitoOperatorPackageName() {
+		namespace, catalog = data.getKogitoOperatorNamespace(), framework.CustomKogitoOperatorCatalog
+	}
+
+	ownedCrds, err := framework.GetOperatorCSVOwnedCRDs(namespace, operatorName, catalog)
+	if err != nil {
+		return err
+	}
+	for _, crd := range ownedCrds {
+		if crd.Name == crdName {
+			return nil
+		}
+	}
+	return fmt.Errorf("Operator %s doesn't own CRD %s", operatorName, crdName)
+}
+
+func (data *Data) operatorPodHasImageTag(expectedTag string) error {
+	namespace := data.getKogitoOperatorNamespace()
+	matches, err := framework.CheckPodImageTag(namespace, framework.GetKogitoOperatorPodLabels(), framework.GetKogitoOperatorContainerName(), expectedTag)
+	if err != nil {
+		return err
+	}
+	if !matches {
+		return fmt.Errorf("Kogito operator pod in namespace %s doesn't have image tag %s", namespace, expectedTag)
+	}
+	return nil
+}
+
+// getKogitoOperatorNamespace returns the namespace where Kogito operator is installed
+func (data *Data) getKogitoOperatorNamespace() string {
+	// Cluster wide operator is installed in OLM namespace
+	if config.IsOperatorNamespaced() {
+		return data.Namespace
+	}
+	return config.GetOlmNamespace()
+}
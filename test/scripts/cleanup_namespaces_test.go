@@ -0,0 +1,41 @@
+// Copyright 2021 Red Hat, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/kiegroup/kogito-operator/meta"
+	"github.com/kiegroup/kogito-operator/test/pkg/framework"
+)
+
+// namespacePrefix is provided with `go test ./scripts -run TestCleanupBDDNamespaces -args -namespace_prefix=<prefix>`
+var namespacePrefix = flag.String("namespace_prefix", "", "Prefix of the BDD namespaces to delete")
+
+func TestCleanupBDDNamespaces(t *testing.T) {
+	if len(*namespacePrefix) == 0 {
+		t.Skip("No namespace prefix provided, skipping namespaces cleanup")
+	}
+
+	// Create kube client
+	if err := framework.InitKubeClient(meta.GetRegisteredSchema()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := framework.CleanupBDDNamespaces(*namespacePrefix); err != nil {
+		t.Fatal(err)
+	}
+}